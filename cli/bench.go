@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// benchCommand benchmarks the passing test cases of problems
+func benchCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("bench")
+	timeout := flags.Duration("timeout", 5*time.Second, "time limit of the first run of each case, which must pass to be benchmarked")
+	format := formatFlag(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if err := checkFormat(*format); err != nil {
+		return usageError(flags, "%v", err)
+	}
+
+	jobs, err := findJobs(flags.Args())
+	if err != nil {
+		log.Print(err)
+		return exitNoTests
+	}
+
+	testRunner := runner.New(registry, runner.Options{Timeout: *timeout})
+	var results []runner.BenchResult
+	for _, job := range jobs {
+		results = append(results, testRunner.Bench(job)...)
+	}
+
+	if *format == "json" {
+		return writeJSON(results)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROBLEM\tCASE\tRUNS\tNS/OP\tB/OP\tALLOCS/OP")
+	for _, result := range results {
+		if result.Result.Status != runner.StatusPass {
+			fmt.Fprintf(w, "%s\t%s\t%s\t-\t-\t-\n", result.Problem, result.Case, result.Result.Status)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\n", result.Problem, result.Case, result.N, result.NsPerOp, result.BytesPerOp, result.AllocsPerOp)
+	}
+	w.Flush()
+	return exitOK
+}
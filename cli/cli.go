@@ -0,0 +1,185 @@
+// Package cli implements the command line interface of the test runner
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"leetcodedaily/solver"
+)
+
+// program is how the tool is invoked in usage messages
+const program = "go run main.go"
+
+// Exit codes of the tool
+const (
+	// exitOK means all cases passed
+	exitOK = 0
+	// exitFailure means cases failed, or the command failed
+	exitFailure = 1
+	// exitUsage means the command line was invalid
+	exitUsage = 2
+	// exitParseErrors means test files could not be parsed
+	exitParseErrors = 3
+	// exitNoTests means no test cases were found or run
+	exitNoTests = 4
+)
+
+// command is a subcommand of the CLI
+type command struct {
+	name string
+	// args is the synopsis of the arguments, e.g. "[flags] [problem...]"
+	args    string
+	summary string
+	run     func(registry *solver.Registry, args []string) int
+}
+
+// commands returns the subcommands in the order they are listed in the help
+func commands() []command {
+	return []command{
+		{"run", "[flags] [problem...]", "Run the test cases of all or the given problems", runCommand},
+		{"list", "[flags]", "List the problems and their test cases", listCommand},
+		{"new", "[flags] <problem>", "Create a new problem and its test case directory", newCommand},
+		{"bench", "[flags] [problem...]", "Benchmark the passing test cases of problems", benchCommand},
+		{"gen", "", "Regenerate the problem bindings (go generate ./problems)", genCommand},
+		{"stats", "[flags]", "Summarize the problems, test cases and directives", statsCommand},
+		{"show", "[flags] <problem>", "Show the binding and the test cases of a problem", showCommand},
+		{"watch", "[flags] [problem...] [-- run flags]", "Rerun problems when their solution or test files change", watchCommand},
+	}
+}
+
+// Main runs the CLI with the arguments after the program name and returns
+// the exit code. Arguments that don't start with a command are passed to
+// "run", so "go run main.go two_sum" keeps working.
+func Main(registry *solver.Registry, args []string) int {
+	// Global flags come before the command
+	for len(args) > 0 {
+		switch arg := args[0]; {
+		case arg == "-C" || arg == "--C":
+			if len(args) < 2 {
+				fmt.Fprintf(os.Stderr, "flag needs an argument: %s\n", arg)
+				return exitUsage
+			}
+			if err := os.Chdir(args[1]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return exitUsage
+			}
+			args = args[2:]
+			continue
+		case strings.HasPrefix(arg, "-C=") || strings.HasPrefix(arg, "--C="):
+			_, dir, _ := strings.Cut(arg, "=")
+			if err := os.Chdir(dir); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return exitUsage
+			}
+			args = args[1:]
+			continue
+		case arg == "-h" || arg == "-help" || arg == "--help":
+			usage(os.Stdout)
+			return exitOK
+		}
+		break
+	}
+
+	if len(args) == 0 {
+		return runCommand(registry, nil)
+	}
+
+	if args[0] == "help" {
+		if len(args) > 1 {
+			if cmd, ok := lookupCommand(args[1]); ok {
+				return cmd.run(registry, []string{"-help"})
+			}
+			fmt.Fprintf(os.Stderr, "unknown command %q\n\n", args[1])
+			usage(os.Stderr)
+			return exitUsage
+		}
+		usage(os.Stdout)
+		return exitOK
+	}
+
+	if cmd, ok := lookupCommand(args[0]); ok {
+		return cmd.run(registry, args[1:])
+	}
+	return runCommand(registry, args)
+}
+
+// lookupCommand finds a command by name
+func lookupCommand(name string) (command, bool) {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// usage prints the help of the tool
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s [-C dir] <command> [flags] [args]\n\n", program)
+	fmt.Fprintf(w, "Commands:\n")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-7s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nGlobal flags:\n")
+	fmt.Fprintf(w, "  -C dir  run as if started in dir\n")
+	fmt.Fprintf(w, "\nWithout a command, the arguments are passed to run. Use \"%s help <command>\" for the flags of a command.\n", program)
+}
+
+// newFlagSet creates the flag set of a command with a usage message built
+// from its synopsis
+func newFlagSet(name string) *flag.FlagSet {
+	cmd, _ := lookupCommand(name)
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		w := flags.Output()
+		fmt.Fprintf(w, "Usage: %s %s %s\n\n%s.\n", program, cmd.name, cmd.args, cmd.summary)
+		hasFlags := false
+		flags.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintf(w, "\nFlags:\n")
+			flags.PrintDefaults()
+		}
+	}
+	return flags
+}
+
+// parseFlags parses the flags of a command. It returns false with the exit
+// code if the command should stop, e.g. after printing its help.
+func parseFlags(flags *flag.FlagSet, args []string) (int, bool) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK, false
+		}
+		return exitUsage, false
+	}
+	return exitOK, true
+}
+
+// usageError prints an error about the arguments of a command and returns
+// the exit code for usage errors
+func usageError(flags *flag.FlagSet, format string, args ...interface{}) int {
+	fmt.Fprintf(os.Stderr, format+"\n\n", args...)
+	flags.SetOutput(os.Stderr)
+	flags.Usage()
+	return exitUsage
+}
+
+// formatFlag adds the -format flag of a command
+func formatFlag(flags *flag.FlagSet) *string {
+	return flags.String("format", "text", "output format: text or json")
+}
+
+// checkFormat validates the value of a -format flag
+func checkFormat(format string) error {
+	switch format {
+	case "text", "json":
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q (expected text or json)", format)
+	}
+}
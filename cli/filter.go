@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"leetcodedaily/report"
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// lastRunFile records the failing cases of the last run for -failed,
+// relative to the working directory
+const lastRunFile = ".leetcode-last-run.json"
+
+// listFlag is a flag that collects comma-separated values and can be
+// repeated, e.g. -tag array,hash-table -tag graph
+type listFlag []string
+
+// String implements flag.Value
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set implements flag.Value
+func (l *listFlag) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// filterFlags are the test selection flags of the run command
+type filterFlags struct {
+	run, cases, tags, difficulties, exclude listFlag
+	failed                                  bool
+}
+
+// addFilterFlags adds the test selection flags to a flag set
+func addFilterFlags(flags *flag.FlagSet) *filterFlags {
+	f := &filterFlags{}
+	flags.Var(&f.run, "run", "only run problems matching the glob patterns, e.g. 'two_*'")
+	flags.Var(&f.cases, "case", "only run cases matching the glob patterns, e.g. test2 or 'test1#*'")
+	flags.Var(&f.tags, "tag", "only run problems with any of the tags, e.g. hash-table")
+	flags.Var(&f.difficulties, "difficulty", "only run problems of the difficulties: easy, medium or hard")
+	flags.Var(&f.exclude, "exclude", "leave out problems, or cases as problem/case, matching the glob patterns")
+	flags.BoolVar(&f.failed, "failed", false, "only rerun the cases that failed in the last run")
+	return f
+}
+
+// filter builds the runner filter from the flags
+func (f *filterFlags) filter() (runner.Filter, error) {
+	filter := runner.Filter{
+		Problems: f.run,
+		Cases:    f.cases,
+		Tags:     f.tags,
+		Exclude:  f.exclude,
+	}
+	for _, name := range f.difficulties {
+		difficulty, err := solver.ParseDifficulty(name)
+		if err != nil {
+			return filter, err
+		}
+		filter.Difficulties = append(filter.Difficulties, difficulty)
+	}
+	if err := filter.ValidatePatterns(); err != nil {
+		return filter, err
+	}
+
+	if f.failed {
+		state, err := loadLastRun()
+		if err != nil {
+			return filter, err
+		}
+		filter.Only = state.Failed
+		if filter.Only == nil {
+			filter.Only = map[solver.ProblemType][]string{}
+		}
+	}
+	return filter, nil
+}
+
+// lastRun is the state saved after every run
+type lastRun struct {
+	// Failed are the names of the failing cases of each problem
+	Failed map[solver.ProblemType][]string `json:"failed"`
+}
+
+// loadLastRun reads the state of the last run, which is empty before the
+// first run
+func loadLastRun() (lastRun, error) {
+	var state lastRun
+	data, err := os.ReadFile(lastRunFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("invalid %s: %w", lastRunFile, err)
+	}
+	return state, nil
+}
+
+// update records the results of a run. Failing cases are added and passing
+// cases removed, so the failures of problems and cases that didn't run are
+// kept.
+func (s *lastRun) update(results []runner.Result) {
+	if s.Failed == nil {
+		s.Failed = make(map[solver.ProblemType][]string)
+	}
+	for _, result := range results {
+		cases := s.Failed[result.Problem]
+		switch {
+		case result.Status.Failed() && !slices.Contains(cases, result.Case):
+			cases = append(cases, result.Case)
+			sort.Strings(cases)
+		case result.Status == runner.StatusPass:
+			// A file that failed to parse is recorded by its name
+			cases = removeCase(removeCase(cases, result.Case), filepath.Base(result.File))
+		}
+
+		if len(cases) > 0 {
+			s.Failed[result.Problem] = cases
+		} else {
+			delete(s.Failed, result.Problem)
+		}
+	}
+}
+
+// save writes the state of the last run
+func (s lastRun) save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(lastRunFile, append(data, '\n'), 0o644)
+}
+
+// removeCase returns the list without the case name
+func removeCase(cases []string, name string) []string {
+	var kept []string
+	for _, c := range cases {
+		if c != name {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// recorder passes the results of a run on to a reporter and keeps them
+type recorder struct {
+	report.Reporter
+	results []runner.Result
+}
+
+// CaseDone implements runner.Listener
+func (r *recorder) CaseDone(result runner.Result) {
+	r.results = append(r.results, result)
+	r.Reporter.CaseDone(result)
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+func TestLastRunUpdate(t *testing.T) {
+	result := func(problem solver.ProblemType, name string, status runner.Status) runner.Result {
+		return runner.Result{Problem: problem, Case: name, File: "test_cases/" + string(problem) + "/test1.txt", Status: status}
+	}
+	tests := []struct {
+		name    string
+		failed  map[solver.ProblemType][]string
+		results []runner.Result
+		want    map[solver.ProblemType][]string
+	}{
+		{
+			name: "failures are added in order",
+			results: []runner.Result{
+				result("two_sum", "test1.txt#2", runner.StatusFail),
+				result("two_sum", "test1.txt#1", runner.StatusTLE),
+				result("move_zeroes", "test1.txt", runner.StatusRuntimeError),
+			},
+			want: map[solver.ProblemType][]string{
+				"two_sum":     {"test1.txt#1", "test1.txt#2"},
+				"move_zeroes": {"test1.txt"},
+			},
+		},
+		{
+			name:    "a failure is recorded once",
+			failed:  map[solver.ProblemType][]string{"two_sum": {"test1.txt#2"}},
+			results: []runner.Result{result("two_sum", "test1.txt#2", runner.StatusMLE)},
+			want:    map[solver.ProblemType][]string{"two_sum": {"test1.txt#2"}},
+		},
+		{
+			name:    "passing cases are removed",
+			failed:  map[solver.ProblemType][]string{"two_sum": {"test1.txt#1", "test1.txt#2"}},
+			results: []runner.Result{result("two_sum", "test1.txt#1", runner.StatusPass)},
+			want:    map[solver.ProblemType][]string{"two_sum": {"test1.txt#2"}},
+		},
+		{
+			name:    "problems without failures are removed",
+			failed:  map[solver.ProblemType][]string{"two_sum": {"test1.txt#1"}},
+			results: []runner.Result{result("two_sum", "test1.txt#1", runner.StatusPass)},
+			want:    map[solver.ProblemType][]string{},
+		},
+		{
+			name:    "a file that failed to parse is removed once a case of it passes",
+			failed:  map[solver.ProblemType][]string{"two_sum": {"test1.txt"}},
+			results: []runner.Result{result("two_sum", "test1.txt#3", runner.StatusPass)},
+			want:    map[solver.ProblemType][]string{},
+		},
+		{
+			name:    "parse errors are recorded by file",
+			results: []runner.Result{result("two_sum", "test1.txt", runner.StatusParseError)},
+			want:    map[solver.ProblemType][]string{"two_sum": {"test1.txt"}},
+		},
+		{
+			name:    "skipped cases are kept",
+			failed:  map[solver.ProblemType][]string{"two_sum": {"test1.txt#1"}},
+			results: []runner.Result{result("two_sum", "test1.txt#1", runner.StatusSkip)},
+			want:    map[solver.ProblemType][]string{"two_sum": {"test1.txt#1"}},
+		},
+		{
+			name:    "failures of problems that didn't run are kept",
+			failed:  map[solver.ProblemType][]string{"move_zeroes": {"test2.txt"}},
+			results: []runner.Result{result("two_sum", "test1.txt#1", runner.StatusPass)},
+			want:    map[solver.ProblemType][]string{"move_zeroes": {"test2.txt"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := lastRun{Failed: tt.failed}
+			state.update(tt.results)
+			if !reflect.DeepEqual(state.Failed, tt.want) {
+				t.Errorf("Failed = %v, want %v", state.Failed, tt.want)
+			}
+		})
+	}
+}
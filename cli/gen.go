@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+
+	"leetcodedaily/solver"
+)
+
+// genCommand regenerates the problem bindings
+func genCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("gen")
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "gen takes no arguments")
+	}
+
+	if err := generateBindings(); err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// generateBindings runs go generate on the problems directory
+func generateBindings() error {
+	cmd := exec.Command("go", "generate", "./"+problemsDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go generate failed: %w", err)
+	}
+	return nil
+}
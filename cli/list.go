@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"leetcodedaily/solver"
+)
+
+// listCommand lists the problems and their test cases
+func listCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("list")
+	format := formatFlag(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if err := checkFormat(*format); err != nil {
+		return usageError(flags, "%v", err)
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "list takes no arguments")
+	}
+
+	infos, err := describeProblems(registry)
+	if err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+
+	if *format == "json" {
+		return writeJSON(infos)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROBLEM\tFUNCTION\tFILES\tCASES\tJUDGE")
+	for _, info := range infos {
+		function := "(no solver)"
+		if info.Function != "" {
+			function = shortFuncName(info.Function)
+		}
+		cases := fmt.Sprint(info.Cases)
+		if len(info.ParseErrors) > 0 {
+			cases += fmt.Sprintf(" (%d invalid)", len(info.ParseErrors))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", info.Name, function, len(info.TestFiles), cases, judge(info))
+	}
+	w.Flush()
+	return exitOK
+}
+
+// describeProblems describes every known problem
+func describeProblems(registry *solver.Registry) ([]problemInfo, error) {
+	names, err := problemNames(registry)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]problemInfo, 0, len(names))
+	for _, name := range names {
+		info, err := describeProblem(registry, name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// judge summarizes how the output of a problem is judged, e.g. "unordered"
+// or "checker CheckOrder, inplace nums"
+func judge(info problemInfo) string {
+	if info.Function == "" {
+		return "-"
+	}
+
+	parts := []string{info.Compare}
+	if info.Checker != "" {
+		parts = []string{"checker " + shortFuncName(info.Checker)}
+	}
+	if info.InPlace != "" {
+		parts = append(parts, "inplace "+info.InPlace)
+	}
+	if info.Judge != "" {
+		parts = append(parts, "judge "+shortFuncName(info.Judge))
+	}
+	if info.CallBudget > 0 {
+		parts = append(parts, fmt.Sprintf("calls %d", info.CallBudget))
+	}
+	if info.TimeLimit != "" {
+		parts = append(parts, "timeout "+info.TimeLimit)
+	}
+	if info.MemoryLimit != "" {
+		parts = append(parts, "memory "+info.MemoryLimit)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// shortFuncName strips the import path from a qualified function name, e.g.
+// "two_sum.TwoSum" for "leetcodedaily/problems/two_sum.TwoSum"
+func shortFuncName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// writeJSON prints a value as indented JSON
+func writeJSON(v interface{}) int {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+	return exitOK
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"leetcodedaily/solver"
+)
+
+// problemNamePattern matches valid problem names, which are also package names
+var problemNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// problemTemplate is the source of a new problem implementation
+const problemTemplate = `package %s
+
+// TODO: Implement the solution here
+// For example:
+//
+// func TwoSum(nums []int, target int) []int {
+//     // ...implementation...
+// }
+`
+
+// newCommand creates a new problem and its test case directory
+func newCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("new")
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if flags.NArg() != 1 {
+		return usageError(flags, "new expects one problem name, e.g. three_sum")
+	}
+
+	name := flags.Arg(0)
+	if !problemNamePattern.MatchString(name) {
+		return usageError(flags, "invalid problem name %q (use lowercase letters, digits and underscores, e.g. three_sum)", name)
+	}
+
+	source := filepath.Join(problemsDir, name, name+".go")
+	if _, err := os.Stat(source); err == nil {
+		log.Printf("Problem %s already exists at %s", name, source)
+		return exitFailure
+	}
+
+	// Create problem directory
+	for _, dir := range []string{filepath.Join(problemsDir, name), filepath.Join(testCasesDir, name)} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			log.Print(err)
+			return exitFailure
+		}
+	}
+
+	// Generate problem implementation file
+	if err := os.WriteFile(source, []byte(fmt.Sprintf(problemTemplate, name)), 0o644); err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+
+	fmt.Printf("Created problem template at %s\n", source)
+	fmt.Printf("Created test cases directory at %s\n", filepath.Join(testCasesDir, name))
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("1. Implement your solution in %s\n", source)
+	fmt.Printf("2. Regenerate the problem bindings with: %s gen\n", program)
+	fmt.Printf("3. Add test cases in %s/ following the existing format\n", filepath.Join(testCasesDir, name))
+	fmt.Printf("4. Run the tests with: %s run %s\n", program, name)
+	return exitOK
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// Directories of the problem implementations and of their test cases,
+// relative to the working directory
+const (
+	problemsDir  = "problems"
+	testCasesDir = "test_cases"
+)
+
+// testFiles returns the test files of a problem
+func testFiles(problem string) ([]string, error) {
+	return filepath.Glob(filepath.Join(testCasesDir, problem, "*.txt"))
+}
+
+// problemNames returns the names of the problems with a test case directory
+// or a registered solver, sorted by name
+func problemNames(registry *solver.Registry) ([]string, error) {
+	seen := make(map[string]bool)
+	for _, problemType := range registry.ListRegisteredProblems() {
+		seen[string(problemType)] = true
+	}
+
+	entries, err := os.ReadDir(testCasesDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			seen[entry.Name()] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// findJobs returns a job for each of the named problems, or for every
+// problem with test cases if no names are given. Problems without test files
+// are logged and left out.
+func findJobs(names []string) ([]runner.Job, error) {
+	// Find all problem directories
+	if len(names) == 0 {
+		entries, err := os.ReadDir(testCasesDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s directory: %w", testCasesDir, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				names = append(names, entry.Name())
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no problem directories found in %s/", testCasesDir)
+	}
+
+	// Find the test files of each problem
+	var jobs []runner.Job
+	for _, problem := range names {
+		files, err := testFiles(problem)
+		if err != nil {
+			log.Printf("Error finding test files for %s: %v", problem, err)
+			continue
+		}
+
+		if len(files) == 0 {
+			log.Printf("No test files found for problem %s", problem)
+			continue
+		}
+
+		jobs = append(jobs, runner.Job{Problem: solver.ProblemType(problem), Files: files})
+	}
+
+	return jobs, nil
+}
+
+// problemInfo describes a problem for the list, show and stats commands
+type problemInfo struct {
+	Name string `json:"name"`
+	// Solved is set if the problem has a registered solver
+	Solved bool `json:"solved"`
+	// Function is the qualified name of the solution function
+	Function    string   `json:"function,omitempty"`
+	Params      []string `json:"params,omitempty"`
+	Source      string   `json:"source,omitempty"`
+	Compare     string   `json:"compare,omitempty"`
+	Checker     string   `json:"checker,omitempty"`
+	InPlace     string   `json:"inplace,omitempty"`
+	Judge       string   `json:"judge,omitempty"`
+	CallBudget  int      `json:"call_budget,omitempty"`
+	TimeLimit   string   `json:"time_limit,omitempty"`
+	MemoryLimit string   `json:"memory_limit,omitempty"`
+	Difficulty  string   `json:"difficulty,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	TestFiles   []string `json:"test_files"`
+	// Cases is the number of examples in the test files
+	Cases int `json:"cases"`
+	// ParseErrors are the errors of the test files that can't be parsed
+	ParseErrors []string `json:"parse_errors,omitempty"`
+	// testCases are the parsed cases, used by the show command
+	testCases []solver.TestCase
+}
+
+// describeProblem collects the binding and the test cases of a problem
+func describeProblem(registry *solver.Registry, name string) (problemInfo, error) {
+	info := problemInfo{Name: name, TestFiles: []string{}}
+
+	files, err := testFiles(name)
+	if err != nil {
+		return info, err
+	}
+	info.TestFiles = append(info.TestFiles, files...)
+
+	if b, ok := solver.LookupBinding(solver.ProblemType(name)); ok {
+		info.Function = funcName(b.Func)
+		info.Params = b.Params
+		info.Source = filepath.Join(problemsDir, name, name+".go")
+		info.Compare = string(b.Compare)
+		if info.Compare == "" {
+			info.Compare = string(solver.CompareExact)
+		}
+		if b.Checker != nil {
+			info.Checker = funcName(b.Checker)
+		}
+		info.InPlace = b.InPlace
+		if b.Judge != nil {
+			info.Judge = funcName(b.Judge)
+		}
+		info.CallBudget = b.Limits.Calls
+		if b.Limits.Time > 0 {
+			info.TimeLimit = b.Limits.Time.String()
+		}
+		if b.Limits.Memory > 0 {
+			info.MemoryLimit = b.Limits.Memory.String()
+		}
+		info.Difficulty = string(b.Difficulty)
+		info.Tags = b.Tags
+	}
+
+	problemSolver, exists := registry.Get(solver.ProblemType(name))
+	info.Solved = exists
+	parser, ok := problemSolver.(solver.TestCaseParser)
+	if !ok {
+		return info, nil
+	}
+
+	for _, file := range files {
+		testCases, err := parser.ParseTestCases(file)
+		if err != nil {
+			info.ParseErrors = append(info.ParseErrors, err.Error())
+			continue
+		}
+		info.Cases += len(testCases)
+		info.testCases = append(info.testCases, testCases...)
+	}
+
+	return info, nil
+}
+
+// funcName returns the qualified name of a function value, such as
+// "leetcodedaily/problems/two_sum.TwoSum"
+func funcName(fn interface{}) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Sprintf("%T", fn)
+	}
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+	return v.Type().String()
+}
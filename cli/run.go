@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"leetcodedaily/report"
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// runCommand runs the test cases of problems
+func runCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("run")
+	timeout := flags.Duration("timeout", 5*time.Second, "default time limit per test case (0 for no limit)")
+	isolate := flags.String("isolate", string(runner.IsolateNone), "run solutions in child processes: none, problem or case")
+	memoryLimit := 512 * solver.Megabyte
+	flags.Var(&memoryLimit, "memory", "memory limit of isolated solutions, e.g. 256MB (0 for no limit)")
+	workers := flags.Int("j", 1, "number of test cases to run in parallel (0 for one per CPU)")
+	quiet := flags.Bool("q", false, "only print failing cases and the summary (text format)")
+	verbose := flags.Bool("v", false, "also print the input of each case (text format)")
+	failFast := flags.Bool("fail-fast", false, "stop after the first failing case")
+	format := flags.String("format", "text", "report format: "+strings.Join(report.Formats(), ", "))
+	selection := addFilterFlags(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	filter, err := selection.filter()
+	if err != nil {
+		return usageError(flags, "%v", err)
+	}
+	if filter.Only != nil && len(filter.Only) == 0 {
+		log.Printf("No failed cases recorded by the last run")
+		return exitNoTests
+	}
+
+	isolation, err := runner.ParseIsolation(*isolate)
+	if err != nil {
+		return usageError(flags, "%v", err)
+	}
+	reporter, err := report.New(*format, os.Stdout, report.Options{
+		Quiet:   *quiet,
+		Verbose: *verbose,
+		Color:   isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == "",
+	})
+	if err != nil {
+		return usageError(flags, "%v", err)
+	}
+
+	jobs, err := findJobs(flags.Args())
+	if err != nil {
+		log.Print(err)
+		return exitNoTests
+	}
+
+	testRunner := runner.New(registry, runner.Options{
+		Timeout:     *timeout,
+		Isolation:   isolation,
+		MemoryLimit: memoryLimit,
+		Workers:     parallelism(*workers),
+		FailFast:    *failFast,
+		Filter:      filter,
+	})
+
+	// Run tests for each problem, recording the failing cases for -failed
+	results := &recorder{Reporter: reporter}
+	summary := report.Run(testRunner, jobs, results)
+	if summary.Total() == 0 {
+		log.Printf("No test cases match the selection")
+	}
+
+	state, err := loadLastRun()
+	if err != nil {
+		log.Print(err)
+		state = lastRun{}
+	}
+	state.update(results.results)
+	if err := state.save(); err != nil {
+		log.Printf("Error saving %s: %v", lastRunFile, err)
+	}
+	return exitCode(summary)
+}
+
+// parallelism returns the number of workers for the -j flag
+func parallelism(j int) int {
+	if j <= 0 {
+		return runtime.NumCPU()
+	}
+	return j
+}
+
+// isTerminal reports whether a file is a terminal, so output to it can be
+// colored
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// exitCode returns the exit code for the results of a run. Parse errors take
+// precedence over failing cases, and a run without passing or failing cases
+// found no tests.
+func exitCode(summary report.Summary) int {
+	switch {
+	case summary.ParseErrors > 0:
+		return exitParseErrors
+	case summary.Failed > 0:
+		return exitFailure
+	case summary.Passed == 0:
+		return exitNoTests
+	default:
+		return exitOK
+	}
+}
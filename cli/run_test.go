@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"leetcodedaily/report"
+	"leetcodedaily/runner"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		statuses []runner.Status
+		want     int
+	}{
+		{nil, exitNoTests},
+		{[]runner.Status{runner.StatusSkip}, exitNoTests},
+		{[]runner.Status{runner.StatusPass}, exitOK},
+		{[]runner.Status{runner.StatusPass, runner.StatusSkip}, exitOK},
+		{[]runner.Status{runner.StatusPass, runner.StatusFail}, exitFailure},
+		{[]runner.Status{runner.StatusFail, runner.StatusSkip}, exitFailure},
+		{[]runner.Status{runner.StatusTLE}, exitFailure},
+		{[]runner.Status{runner.StatusMLE}, exitFailure},
+		{[]runner.Status{runner.StatusRuntimeError}, exitFailure},
+		{[]runner.Status{runner.StatusParseError}, exitParseErrors},
+		{[]runner.Status{runner.StatusPass, runner.StatusParseError}, exitParseErrors},
+		{[]runner.Status{runner.StatusFail, runner.StatusParseError, runner.StatusSkip}, exitParseErrors},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.statuses), func(t *testing.T) {
+			var summary report.Summary
+			for _, status := range tt.statuses {
+				summary.Add(runner.Result{Status: status})
+			}
+			if got := exitCode(summary); got != tt.want {
+				t.Errorf("exitCode(%+v) = %d, want %d", summary, got, tt.want)
+			}
+		})
+	}
+}
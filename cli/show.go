@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"leetcodedaily/solver"
+)
+
+// caseInfo is a parsed test case in the JSON output of the show command
+type caseInfo struct {
+	Name     string `json:"name"`
+	File     string `json:"file"`
+	Input    string `json:"input"`
+	Expected string `json:"expected"`
+}
+
+// showCommand shows the binding and the test cases of a problem
+func showCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("show")
+	format := formatFlag(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if err := checkFormat(*format); err != nil {
+		return usageError(flags, "%v", err)
+	}
+	if flags.NArg() != 1 {
+		return usageError(flags, "show expects one problem")
+	}
+
+	name := flags.Arg(0)
+	info, err := describeProblem(registry, name)
+	if err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+	if !info.Solved && len(info.TestFiles) == 0 {
+		log.Printf("Unknown problem %s", name)
+		return exitFailure
+	}
+
+	cases := make([]caseInfo, len(info.testCases))
+	for i, testCase := range info.testCases {
+		cases[i] = caseInfo{
+			Name:     testCase.Name,
+			File:     testCase.FilePath,
+			Input:    testCase.FormatInput(),
+			Expected: fmt.Sprintf("%v", testCase.ExpectedOutput),
+		}
+	}
+
+	if *format == "json" {
+		return writeJSON(struct {
+			problemInfo
+			TestCases []caseInfo `json:"test_cases"`
+		}{info, cases})
+	}
+
+	fmt.Printf("Problem:  %s\n", info.Name)
+	if info.Function == "" {
+		fmt.Printf("Solution: none (run `%s gen` after implementing it)\n", program)
+	} else {
+		fmt.Printf("Solution: %s(%s)\n", shortFuncName(info.Function), strings.Join(info.Params, ", "))
+		fmt.Printf("Source:   %s\n", info.Source)
+		fmt.Printf("Judge:    %s\n", judge(info))
+		if info.Difficulty != "" {
+			fmt.Printf("Level:    %s\n", info.Difficulty)
+		}
+		if len(info.Tags) > 0 {
+			fmt.Printf("Tags:     %s\n", strings.Join(info.Tags, ", "))
+		}
+	}
+
+	fmt.Printf("\nTest cases (%d):\n", info.Cases)
+	for _, c := range cases {
+		fmt.Printf("\n  %s\n", c.Name)
+		fmt.Printf("   Input:    %s\n", c.Input)
+		fmt.Printf("   Expected: %s\n", c.Expected)
+	}
+	for _, parseErr := range info.ParseErrors {
+		fmt.Printf("\n  ⚠️ %s\n", parseErr)
+	}
+	return exitOK
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"leetcodedaily/solver"
+)
+
+// stats summarizes the problems and their test cases
+type stats struct {
+	Problems int `json:"problems"`
+	// Unsolved counts problems with test cases but no solver
+	Unsolved    int `json:"unsolved"`
+	TestFiles   int `json:"test_files"`
+	Cases       int `json:"cases"`
+	ParseErrors int `json:"parse_errors"`
+	// WithoutCases counts solved problems without test cases
+	WithoutCases int `json:"without_cases"`
+	// Judges counts the problems per comparison mode, or "checker"
+	Judges map[string]int `json:"judges"`
+	// InPlace and Limited count the problems with inplace and limit directives
+	InPlace int `json:"inplace"`
+	Limited int `json:"limited"`
+}
+
+// statsCommand summarizes the problems, test cases and directives
+func statsCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("stats")
+	format := formatFlag(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if err := checkFormat(*format); err != nil {
+		return usageError(flags, "%v", err)
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "stats takes no arguments")
+	}
+
+	infos, err := describeProblems(registry)
+	if err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+
+	s := stats{Problems: len(infos), Judges: make(map[string]int)}
+	for _, info := range infos {
+		s.TestFiles += len(info.TestFiles)
+		s.Cases += info.Cases
+		s.ParseErrors += len(info.ParseErrors)
+
+		if !info.Solved {
+			s.Unsolved++
+			continue
+		}
+		if len(info.TestFiles) == 0 {
+			s.WithoutCases++
+		}
+		if info.Checker != "" {
+			s.Judges["checker"]++
+		} else {
+			s.Judges[info.Compare]++
+		}
+		if info.InPlace != "" {
+			s.InPlace++
+		}
+		if info.TimeLimit != "" || info.MemoryLimit != "" {
+			s.Limited++
+		}
+	}
+
+	if *format == "json" {
+		return writeJSON(s)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Problems:\t%d\n", s.Problems)
+	fmt.Fprintf(w, "  without a solver:\t%d\n", s.Unsolved)
+	fmt.Fprintf(w, "  without test cases:\t%d\n", s.WithoutCases)
+	fmt.Fprintf(w, "Test files:\t%d\n", s.TestFiles)
+	fmt.Fprintf(w, "Test cases:\t%d\n", s.Cases)
+	fmt.Fprintf(w, "Parse errors:\t%d\n", s.ParseErrors)
+	fmt.Fprintf(w, "Judged by:\t\n")
+	judges := make([]string, 0, len(s.Judges))
+	for judge := range s.Judges {
+		judges = append(judges, judge)
+	}
+	sort.Strings(judges)
+	for _, judge := range judges {
+		fmt.Fprintf(w, "  %s:\t%d\n", judge, s.Judges[judge])
+	}
+	fmt.Fprintf(w, "In-place problems:\t%d\n", s.InPlace)
+	fmt.Fprintf(w, "Problems with limits:\t%d\n", s.Limited)
+	w.Flush()
+	return exitOK
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// clearScreen moves the cursor home and clears the terminal
+const clearScreen = "\x1b[H\x1b[2J"
+
+// fileStamp identifies a version of a file by its modification time and size
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// watcher rebuilds the tool and reruns problems when their files change
+type watcher struct {
+	// binary is the path the tool is rebuilt to
+	binary string
+	// runArgs are the flags passed to the run command
+	runArgs []string
+	// clear clears the screen before every run
+	clear bool
+}
+
+// watchCommand polls the problem sources and test cases and reruns the
+// problems whose files changed
+func watchCommand(registry *solver.Registry, args []string) int {
+	flags := newFlagSet("watch")
+	interval := flags.Duration("interval", 500*time.Millisecond, "how often to check for changes")
+	clearOutput := flags.Bool("clear", true, "clear the screen before every run (terminal only)")
+	args, runArgs := splitRunArgs(args)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if *interval <= 0 {
+		return usageError(flags, "-interval must be positive")
+	}
+	names := flags.Args()
+
+	dir, err := os.MkdirTemp("", "leetcode-watch")
+	if err != nil {
+		log.Print(err)
+		return exitFailure
+	}
+	defer os.RemoveAll(dir)
+
+	binary := filepath.Join(dir, "leetcode")
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+	w := watcher{binary: binary, runArgs: runArgs, clear: *clearOutput && isTerminal(os.Stdout)}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	stamps := snapshot()
+	w.rerun(names, nil, true)
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-interrupt:
+			fmt.Println()
+			return exitOK
+		case <-ticker.C:
+		}
+
+		next := snapshot()
+		changed := changedFiles(stamps, next)
+		if len(changed) == 0 {
+			continue
+		}
+
+		// Editors may write a file in several steps, so wait for the files
+		// to settle before rebuilding
+		for {
+			time.Sleep(*interval)
+			settled := snapshot()
+			if len(changedFiles(next, settled)) == 0 {
+				break
+			}
+			next = settled
+		}
+		changed = changedFiles(stamps, next)
+		stamps = next
+
+		problems, sourceChanged := affectedProblems(changed, names)
+		if len(problems) > 0 {
+			w.rerun(problems, changed, sourceChanged)
+		}
+	}
+}
+
+// splitRunArgs splits the arguments of the watch command at "--" into its
+// own flags and problems, and the flags for the run command. It runs before
+// flag parsing, which would drop a leading "--".
+func splitRunArgs(args []string) ([]string, []string) {
+	for i, arg := range args {
+		if arg == "--" {
+			return args[:i], args[i+1:]
+		}
+	}
+	return args, nil
+}
+
+// rerun regenerates the bindings if a solution changed, rebuilds the tool
+// and runs the problems, or every problem if none are given
+func (w watcher) rerun(problems, changed []string, regenerate bool) {
+	if w.clear {
+		fmt.Print(clearScreen)
+	}
+	target := "all problems"
+	if len(problems) > 0 {
+		target = strings.Join(problems, ", ")
+	}
+	fmt.Printf("[%s] Running %s", time.Now().Format("15:04:05"), target)
+	if len(changed) > 0 {
+		fmt.Printf(" (changed: %s)", strings.Join(changed, ", "))
+	}
+	fmt.Println()
+
+	defer fmt.Printf("\nWatching %s/ and %s/ for changes (Ctrl+C to stop)...\n", problemsDir, testCasesDir)
+
+	if regenerate {
+		if err := generateBindings(); err != nil {
+			log.Print(err)
+			return
+		}
+	}
+
+	build := exec.Command("go", "build", "-o", w.binary, ".")
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		log.Printf("Build failed: %v", err)
+		return
+	}
+
+	// The exit code of the run is reported by its summary
+	run := exec.Command(w.binary, append(append([]string{"run"}, w.runArgs...), problems...)...)
+	run.Stdout = os.Stdout
+	run.Stderr = os.Stderr
+	run.Run()
+}
+
+// snapshot returns the stamps of the files in the problems and test case
+// directories
+func snapshot() map[string]fileStamp {
+	stamps := make(map[string]fileStamp)
+	for _, dir := range []string{problemsDir, testCasesDir} {
+		filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return nil
+			}
+			if info, err := entry.Info(); err == nil {
+				stamps[path] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+			}
+			return nil
+		})
+	}
+	return stamps
+}
+
+// changedFiles returns the files that were added, modified or removed
+// between two snapshots, sorted by path
+func changedFiles(before, after map[string]fileStamp) []string {
+	var changed []string
+	for path, stamp := range after {
+		if old, ok := before[path]; !ok || !old.modTime.Equal(stamp.modTime) || old.size != stamp.size {
+			changed = append(changed, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// affectedProblems returns the problems whose files changed, limited to the
+// watched problems if any are given, and whether any of their solution
+// sources changed. Files outside of a problem's directory, such as the
+// generated bindings, are ignored.
+func affectedProblems(changed, watched []string) ([]string, bool) {
+	seen := make(map[string]bool)
+	var problems []string
+	sourceChanged := false
+	for _, path := range changed {
+		for _, dir := range []string{problemsDir, testCasesDir} {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			parts := strings.Split(filepath.ToSlash(rel), "/")
+			if len(parts) < 2 {
+				continue
+			}
+			name := parts[0]
+			if len(watched) > 0 && !slices.Contains(watched, name) {
+				continue
+			}
+			if dir == problemsDir {
+				sourceChanged = true
+			}
+			if !seen[name] {
+				seen[name] = true
+				problems = append(problems, name)
+			}
+		}
+	}
+	sort.Strings(problems)
+	return problems, sourceChanged
+}
@@ -0,0 +1,451 @@
+// Command bindgen generates problems/bindings_gen.go, which binds the solution
+// function of every problem in problems/<name>/<name>.go to the solver registry.
+//
+// It is run through go generate from the problems directory:
+//
+//	go generate ./problems
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"leetcodedaily/solver"
+	// Registers the encodings of linked structures, which the encoding
+	// directive is checked against
+	_ "leetcodedaily/structures"
+)
+
+// problemBinding holds everything needed to emit the binding of one problem
+type problemBinding struct {
+	Name       string
+	ImportPath string
+	Package    string
+	Func       string
+	Params     []string
+	Compare    string
+	Checker    string
+	Judge      string
+	InPlace    string
+	Encoding   string
+	Graph      solver.GraphParam
+	Design     bool
+	TimeLimit  time.Duration
+	Memory     solver.ByteSize
+	Calls      int
+	Difficulty string
+	Tags       []string
+}
+
+// designConstructor is the name of the solution function of design problems
+const designConstructor = "Constructor"
+
+// directivePrefix marks directives in the doc comment of a solution function,
+// e.g. "//leetcode:compare unordered"
+const directivePrefix = "//leetcode:"
+
+func main() {
+	dir := flag.String("dir", ".", "problems directory")
+	out := flag.String("out", "bindings_gen.go", "output file, relative to the problems directory")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("bindgen: ")
+
+	modulePath, moduleRoot, err := findModule(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	bindings, err := collectBindings(*dir, modulePath, moduleRoot)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := render(modulePath, bindings)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*dir, *out), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// findModule walks up from dir to the nearest go.mod and returns the module
+// path and the directory that contains it
+func findModule(dir string) (string, string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	for d := abs; ; d = filepath.Dir(d) {
+		data, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				line = strings.TrimSpace(line)
+				if strings.HasPrefix(line, "module ") {
+					return strings.TrimSpace(strings.TrimPrefix(line, "module ")), d, nil
+				}
+			}
+			return "", "", fmt.Errorf("no module directive in %s", filepath.Join(d, "go.mod"))
+		}
+		if filepath.Dir(d) == d {
+			return "", "", fmt.Errorf("no go.mod found above %s", abs)
+		}
+	}
+}
+
+// collectBindings parses problems/<name>/<name>.go for every problem
+// directory and picks its first exported top-level function as the solution
+func collectBindings(dir, modulePath, moduleRoot string) ([]problemBinding, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	relDir, err := filepath.Rel(moduleRoot, absDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []problemBinding
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		mainFile := filepath.Join(dir, name, name+".go")
+		if _, err := os.Stat(mainFile); err != nil {
+			log.Printf("skipping %s: %s not found", name, mainFile)
+			continue
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, mainFile, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		fn := findSolution(file)
+		if fn == nil {
+			log.Printf("skipping %s: no exported function in %s", name, mainFile)
+			continue
+		}
+
+		params, err := paramNames(fn)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", fset.Position(fn.Pos()), fn.Name.Name, err)
+		}
+
+		binding := problemBinding{
+			Name:       name,
+			ImportPath: filepath.ToSlash(filepath.Join(modulePath, relDir, name)),
+			Package:    file.Name.Name,
+			Func:       fn.Name.Name,
+			Params:     params,
+			// LeetCode names the constructor of design problems Constructor
+			Design: fn.Name.Name == designConstructor,
+		}
+		if err := applyDirectives(&binding, fn); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", fset.Position(fn.Pos()), fn.Name.Name, err)
+		}
+
+		bindings = append(bindings, binding)
+	}
+
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].Name < bindings[j].Name })
+	return bindings, nil
+}
+
+// findSolution returns the first exported top-level function of a file
+func findSolution(file *ast.File) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Recv == nil && fn.Name.IsExported() {
+			return fn
+		}
+	}
+	return nil
+}
+
+// paramNames returns the parameter names of a function in declaration order
+func paramNames(fn *ast.FuncDecl) ([]string, error) {
+	var names []string
+	for _, field := range fn.Type.Params.List {
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("parameters must be named to match the test case inputs")
+		}
+		for _, ident := range field.Names {
+			names = append(names, ident.Name)
+		}
+	}
+	return names, nil
+}
+
+// applyDirectives reads the "//leetcode:" directives in the doc comment of
+// a solution function into its binding
+func applyDirectives(b *problemBinding, fn *ast.FuncDecl) error {
+	if fn.Doc == nil {
+		return nil
+	}
+
+	for _, comment := range fn.Doc.List {
+		if !strings.HasPrefix(comment.Text, directivePrefix) {
+			continue
+		}
+
+		name, value, _ := strings.Cut(strings.TrimPrefix(comment.Text, directivePrefix), " ")
+		value = strings.TrimSpace(value)
+
+		switch name {
+		case "compare":
+			mode, err := solver.ParseCompareMode(value)
+			if err != nil {
+				return err
+			}
+			b.Compare = string(mode)
+		case "checker":
+			if !token.IsIdentifier(value) || !token.IsExported(value) {
+				return fmt.Errorf("%schecker expects the name of an exported function, got %q", directivePrefix, value)
+			}
+			b.Checker = value
+		case "judge":
+			if !token.IsIdentifier(value) || !token.IsExported(value) {
+				return fmt.Errorf("%sjudge expects the name of an exported function, got %q", directivePrefix, value)
+			}
+			b.Judge = value
+		case "calls":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("%scalls expects a positive number of calls, got %q", directivePrefix, value)
+			}
+			b.Calls = n
+		case "inplace":
+			if !slices.Contains(b.Params, value) {
+				return fmt.Errorf("%sinplace expects a parameter name, got %q", directivePrefix, value)
+			}
+			b.InPlace = value
+		case "encoding":
+			if _, ok := solver.LookupEncoding(value); !ok {
+				return fmt.Errorf("%sencoding expects one of %v, got %q", directivePrefix, solver.EncodingNames(), value)
+			}
+			b.Encoding = value
+		case "graph":
+			graph, ok := parseGraphDirective(value, b.Params)
+			if !ok {
+				return fmt.Errorf("%sgraph expects an edge list parameter, a node count parameter and optionally directed, got %q", directivePrefix, value)
+			}
+			b.Graph = graph
+		case "timeout":
+			d, err := time.ParseDuration(value)
+			if err != nil || d <= 0 {
+				return fmt.Errorf("%stimeout expects a positive duration such as 500ms, got %q", directivePrefix, value)
+			}
+			b.TimeLimit = d
+		case "memory":
+			size, err := solver.ParseByteSize(value)
+			if err != nil || size <= 0 {
+				return fmt.Errorf("%smemory expects a positive size such as 64MB, got %q", directivePrefix, value)
+			}
+			b.Memory = size
+		case "difficulty":
+			difficulty, err := solver.ParseDifficulty(value)
+			if err != nil {
+				return err
+			}
+			b.Difficulty = string(difficulty)
+		case "tags":
+			tags, err := solver.ParseTags(value)
+			if err != nil || len(tags) == 0 {
+				return fmt.Errorf("%stags expects a list of tags such as array,hash-table, got %q", directivePrefix, value)
+			}
+			b.Tags = append(b.Tags, tags...)
+		default:
+			return fmt.Errorf("unknown directive %s%s", directivePrefix, name)
+		}
+	}
+
+	if b.Calls > 0 && b.Judge == "" {
+		return fmt.Errorf("%scalls needs a %sjudge that counts the calls", directivePrefix, directivePrefix)
+	}
+	if b.Design && b.Judge != "" {
+		return fmt.Errorf("design problems can't have a %sjudge", directivePrefix)
+	}
+	return nil
+}
+
+// durationExpr formats a duration as a Go expression such as 2 * time.Second
+func durationExpr(d time.Duration) string {
+	units := []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	}
+
+	for _, u := range units {
+		if d%u.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/u.unit, u.name)
+		}
+	}
+	return fmt.Sprintf("%d", int64(d))
+}
+
+// byteSizeExpr formats a size as a Go expression such as 64 * solver.Megabyte
+func byteSizeExpr(size solver.ByteSize) string {
+	units := []struct {
+		unit solver.ByteSize
+		name string
+	}{
+		{solver.Gigabyte, "solver.Gigabyte"},
+		{solver.Megabyte, "solver.Megabyte"},
+		{solver.Kilobyte, "solver.Kilobyte"},
+	}
+
+	for _, u := range units {
+		if size%u.unit == 0 {
+			return fmt.Sprintf("%d * %s", size/u.unit, u.name)
+		}
+	}
+	return fmt.Sprintf("%d", int64(size))
+}
+
+// parseGraphDirective parses the value of a graph directive, such as
+// "edges n" or "prerequisites numCourses directed"
+func parseGraphDirective(value string, params []string) (solver.GraphParam, bool) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 || len(fields) > 3 {
+		return solver.GraphParam{}, false
+	}
+	if !slices.Contains(params, fields[0]) || !slices.Contains(params, fields[1]) {
+		return solver.GraphParam{}, false
+	}
+
+	graph := solver.GraphParam{Param: fields[0], Nodes: fields[1]}
+	if len(fields) == 3 {
+		switch fields[2] {
+		case "directed":
+			graph.Directed = true
+		case "undirected":
+		default:
+			return solver.GraphParam{}, false
+		}
+	}
+	return graph, true
+}
+
+// graphExpr formats a graph parameter as a solver.GraphParam literal
+func graphExpr(g solver.GraphParam) string {
+	expr := fmt.Sprintf("solver.GraphParam{Param: %q, Nodes: %q", g.Param, g.Nodes)
+	if g.Directed {
+		expr += ", Directed: true"
+	}
+	return expr + "}"
+}
+
+// limitsExpr formats the limits of a binding as a solver.Limits literal, or
+// returns "" if the problem has no limits of its own
+func limitsExpr(b problemBinding) string {
+	var fields []string
+	if b.TimeLimit > 0 {
+		fields = append(fields, "Time: "+durationExpr(b.TimeLimit))
+	}
+	if b.Memory > 0 {
+		fields = append(fields, "Memory: "+byteSizeExpr(b.Memory))
+	}
+	if b.Calls > 0 {
+		fields = append(fields, "Calls: "+strconv.Itoa(b.Calls))
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return "solver.Limits{" + strings.Join(fields, ", ") + "}"
+}
+
+// render produces the formatted source of the generated bindings file
+func render(modulePath string, bindings []problemBinding) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by bindgen; DO NOT EDIT.\n\n")
+	buf.WriteString("package problems\n\n")
+	usesTime := false
+	for _, b := range bindings {
+		usesTime = usesTime || b.TimeLimit > 0
+	}
+
+	buf.WriteString("import (\n")
+	if usesTime {
+		buf.WriteString("\t\"time\"\n\n")
+	}
+	for _, b := range bindings {
+		fmt.Fprintf(&buf, "\t%s %q\n", b.Package, b.ImportPath)
+	}
+	fmt.Fprintf(&buf, "\n\t%q\n", modulePath+"/solver")
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("func init() {\n")
+	for _, b := range bindings {
+		fmt.Fprintf(&buf, "\tsolver.Bind(solver.Binding{\n")
+		fmt.Fprintf(&buf, "\t\tProblem: %q,\n", b.Name)
+		fmt.Fprintf(&buf, "\t\tFunc: %s.%s,\n", b.Package, b.Func)
+		fmt.Fprintf(&buf, "\t\tParams: %#v,\n", b.Params)
+		if b.Compare != "" && b.Compare != string(solver.CompareExact) {
+			fmt.Fprintf(&buf, "\t\tCompare: %q,\n", b.Compare)
+		}
+		if b.InPlace != "" {
+			fmt.Fprintf(&buf, "\t\tInPlace: %q,\n", b.InPlace)
+		}
+		if b.Encoding != "" {
+			fmt.Fprintf(&buf, "\t\tEncoding: %q,\n", b.Encoding)
+		}
+		if b.Graph != (solver.GraphParam{}) {
+			fmt.Fprintf(&buf, "\t\tGraph: %s,\n", graphExpr(b.Graph))
+		}
+		if b.Design {
+			buf.WriteString("\t\tDesign: true,\n")
+		}
+		if limits := limitsExpr(b); limits != "" {
+			fmt.Fprintf(&buf, "\t\tLimits: %s,\n", limits)
+		}
+		if b.Checker != "" {
+			fmt.Fprintf(&buf, "\t\tChecker: solver.CheckerFunc(%s.%s),\n", b.Package, b.Checker)
+		}
+		if b.Judge != "" {
+			fmt.Fprintf(&buf, "\t\tJudge: %s.%s,\n", b.Package, b.Judge)
+		}
+		if b.Difficulty != "" {
+			fmt.Fprintf(&buf, "\t\tDifficulty: %q,\n", b.Difficulty)
+		}
+		if len(b.Tags) > 0 {
+			fmt.Fprintf(&buf, "\t\tTags: %#v,\n", b.Tags)
+		}
+		buf.WriteString("\t})\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
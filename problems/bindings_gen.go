@@ -0,0 +1,163 @@
+// Code generated by bindgen; DO NOT EDIT.
+
+package problems
+
+import (
+	clone_graph "leetcodedaily/problems/clone_graph"
+	course_schedule_ii "leetcodedaily/problems/course_schedule_ii"
+	find_if_path_exists "leetcodedaily/problems/find_if_path_exists"
+	first_bad_version "leetcodedaily/problems/first_bad_version"
+	guess_number "leetcodedaily/problems/guess_number"
+	intersection_of_two_lists "leetcodedaily/problems/intersection_of_two_lists"
+	invert_binary_tree "leetcodedaily/problems/invert_binary_tree"
+	linked_list_cycle_ii "leetcodedaily/problems/linked_list_cycle_ii"
+	lru_cache "leetcodedaily/problems/lru_cache"
+	merge_array "leetcodedaily/problems/merge_array"
+	min_stack "leetcodedaily/problems/min_stack"
+	move_zeroes "leetcodedaily/problems/move_zeroes"
+	remove_duplicates "leetcodedaily/problems/remove_duplicates"
+	remove_element "leetcodedaily/problems/remove_element"
+	reverse_linked_list "leetcodedaily/problems/reverse_linked_list"
+	two_sum "leetcodedaily/problems/two_sum"
+	valid_palindrome "leetcodedaily/problems/valid_palindrome"
+
+	"leetcodedaily/solver"
+)
+
+func init() {
+	solver.Bind(solver.Binding{
+		Problem:    "clone_graph",
+		Func:       clone_graph.CloneGraph,
+		Params:     []string{"node"},
+		Encoding:   "clone",
+		Difficulty: "medium",
+		Tags:       []string{"hash-table", "depth-first-search", "breadth-first-search", "graph"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "course_schedule_ii",
+		Func:       course_schedule_ii.FindOrder,
+		Params:     []string{"numCourses", "prerequisites"},
+		Checker:    solver.CheckerFunc(course_schedule_ii.CheckOrder),
+		Difficulty: "medium",
+		Tags:       []string{"depth-first-search", "breadth-first-search", "graph", "topological-sort"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "find_if_path_exists",
+		Func:       find_if_path_exists.ValidPath,
+		Params:     []string{"n", "edges", "source", "destination"},
+		Graph:      solver.GraphParam{Param: "edges", Nodes: "n"},
+		Difficulty: "easy",
+		Tags:       []string{"depth-first-search", "breadth-first-search", "union-find", "graph"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "first_bad_version",
+		Func:       first_bad_version.FirstBadVersion,
+		Params:     []string{"n"},
+		Limits:     solver.Limits{Calls: 31},
+		Judge:      first_bad_version.SetupJudge,
+		Difficulty: "easy",
+		Tags:       []string{"binary-search", "interactive"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "guess_number",
+		Func:       guess_number.GuessNumber,
+		Params:     []string{"n"},
+		Judge:      guess_number.SetupJudge,
+		Difficulty: "easy",
+		Tags:       []string{"binary-search", "interactive"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "intersection_of_two_lists",
+		Func:       intersection_of_two_lists.GetIntersectionNode,
+		Params:     []string{"headA", "headB"},
+		Encoding:   "intersection",
+		Difficulty: "easy",
+		Tags:       []string{"linked-list", "two-pointers"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "invert_binary_tree",
+		Func:       invert_binary_tree.InvertTree,
+		Params:     []string{"root"},
+		Difficulty: "easy",
+		Tags:       []string{"tree", "depth-first-search", "breadth-first-search", "binary-tree"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "linked_list_cycle_ii",
+		Func:       linked_list_cycle_ii.DetectCycle,
+		Params:     []string{"head"},
+		Encoding:   "cycle",
+		Difficulty: "medium",
+		Tags:       []string{"linked-list", "two-pointers"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "lru_cache",
+		Func:       lru_cache.Constructor,
+		Params:     []string{"capacity"},
+		Design:     true,
+		Difficulty: "medium",
+		Tags:       []string{"hash-table", "linked-list", "design", "doubly-linked-list"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "merge_array",
+		Func:       merge_array.Merge,
+		Params:     []string{"nums1", "m", "nums2", "n"},
+		InPlace:    "nums1",
+		Difficulty: "easy",
+		Tags:       []string{"array", "two-pointers", "sorting"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "min_stack",
+		Func:       min_stack.Constructor,
+		Params:     []string(nil),
+		Design:     true,
+		Difficulty: "medium",
+		Tags:       []string{"stack", "design"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "move_zeroes",
+		Func:       move_zeroes.MoveZeroes,
+		Params:     []string{"nums"},
+		InPlace:    "nums",
+		Difficulty: "easy",
+		Tags:       []string{"array", "two-pointers"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "remove_duplicates",
+		Func:       remove_duplicates.RemoveDuplicates,
+		Params:     []string{"nums"},
+		InPlace:    "nums",
+		Difficulty: "easy",
+		Tags:       []string{"array", "two-pointers"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "remove_element",
+		Func:       remove_element.RemoveElement,
+		Params:     []string{"nums", "val"},
+		Compare:    "unordered",
+		InPlace:    "nums",
+		Difficulty: "easy",
+		Tags:       []string{"array", "two-pointers"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "reverse_linked_list",
+		Func:       reverse_linked_list.ReverseList,
+		Params:     []string{"head"},
+		Difficulty: "easy",
+		Tags:       []string{"linked-list", "recursion"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "two_sum",
+		Func:       two_sum.TwoSum,
+		Params:     []string{"nums", "target"},
+		Compare:    "unordered",
+		Difficulty: "easy",
+		Tags:       []string{"array", "hash-table"},
+	})
+	solver.Bind(solver.Binding{
+		Problem:    "valid_palindrome",
+		Func:       valid_palindrome.IsPalindrome,
+		Params:     []string{"s"},
+		Difficulty: "easy",
+		Tags:       []string{"two-pointers", "string"},
+	})
+}
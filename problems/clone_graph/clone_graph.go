@@ -0,0 +1,31 @@
+package clone_graph
+
+import "leetcodedaily/structures"
+
+// Node is the graph node used by LeetCode
+type Node = structures.Node
+
+// CloneGraph returns a deep copy of a connected undirected graph
+//
+//leetcode:encoding clone
+//leetcode:difficulty medium
+//leetcode:tags hash-table,depth-first-search,breadth-first-search,graph
+func CloneGraph(node *Node) *Node {
+	clones := make(map[*Node]*Node)
+	var clone func(*Node) *Node
+	clone = func(n *Node) *Node {
+		if n == nil {
+			return nil
+		}
+		if c, ok := clones[n]; ok {
+			return c
+		}
+		c := &Node{Val: n.Val}
+		clones[n] = c
+		for _, neighbor := range n.Neighbors {
+			c.Neighbors = append(c.Neighbors, clone(neighbor))
+		}
+		return c
+	}
+	return clone(node)
+}
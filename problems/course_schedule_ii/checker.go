@@ -0,0 +1,49 @@
+package course_schedule_ii
+
+import (
+	"leetcodedaily/solver"
+)
+
+// CheckOrder accepts any valid topological ordering of the courses, since
+// the problem allows returning any of them
+func CheckOrder(input map[string]interface{}, expected, actual interface{}) solver.Verdict {
+	numCourses, _ := input["numCourses"].(int)
+	prerequisites, _ := input["prerequisites"].([][]int)
+	order, _ := actual.([]int)
+
+	// An empty expected output means the courses can't be finished
+	if want, _ := expected.([]int); len(want) == 0 {
+		if len(order) != 0 {
+			return solver.Reject("expected no ordering, got %v", order)
+		}
+		return solver.Accept()
+	}
+
+	if len(order) != numCourses {
+		return solver.Reject("expected %d courses, got %d", numCourses, len(order))
+	}
+
+	// Record the position of each course, rejecting unknown and repeated ones
+	position := make([]int, numCourses)
+	for i := range position {
+		position[i] = -1
+	}
+	for i, course := range order {
+		if course < 0 || course >= numCourses {
+			return solver.Reject("unknown course %d at index %d", course, i)
+		}
+		if position[course] != -1 {
+			return solver.Reject("course %d appears more than once", course)
+		}
+		position[course] = i
+	}
+
+	for _, p := range prerequisites {
+		course, pre := p[0], p[1]
+		if position[pre] > position[course] {
+			return solver.Reject("course %d is taken before its prerequisite %d", course, pre)
+		}
+	}
+
+	return solver.Accept()
+}
@@ -0,0 +1,42 @@
+package course_schedule_ii
+
+// FindOrder returns an ordering of courses that satisfies all prerequisites,
+// or an empty slice if no such ordering exists
+//
+//leetcode:checker CheckOrder
+//leetcode:difficulty medium
+//leetcode:tags depth-first-search,breadth-first-search,graph,topological-sort
+func FindOrder(numCourses int, prerequisites [][]int) []int {
+	// Count incoming edges and build the adjacency list
+	inDegree := make([]int, numCourses)
+	next := make([][]int, numCourses)
+	for _, p := range prerequisites {
+		course, pre := p[0], p[1]
+		next[pre] = append(next[pre], course)
+		inDegree[course]++
+	}
+
+	// Start with the courses that have no prerequisites
+	queue := make([]int, 0, numCourses)
+	for course, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, course)
+		}
+	}
+
+	// Take courses in topological order
+	for i := 0; i < len(queue); i++ {
+		for _, course := range next[queue[i]] {
+			inDegree[course]--
+			if inDegree[course] == 0 {
+				queue = append(queue, course)
+			}
+		}
+	}
+
+	// A cycle leaves some courses unreachable
+	if len(queue) != numCourses {
+		return []int{}
+	}
+	return queue
+}
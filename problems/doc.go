@@ -0,0 +1,10 @@
+// Package problems binds the solution of every problem in problems/<name>
+// to the solver registry. Importing it for side effects registers them all.
+//
+// The bindings live in bindings_gen.go and must be regenerated whenever a
+// problem is added or a solution signature changes:
+//
+//	go generate ./problems
+package problems
+
+//go:generate go run ../cmd/bindgen
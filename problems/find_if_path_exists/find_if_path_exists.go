@@ -0,0 +1,27 @@
+package find_if_path_exists
+
+// ValidPath reports whether there is a path from source to destination in an
+// undirected graph, which it receives as an adjacency list
+//
+//leetcode:graph edges n
+//leetcode:difficulty easy
+//leetcode:tags depth-first-search,breadth-first-search,union-find,graph
+func ValidPath(n int, edges [][]int, source int, destination int) bool {
+	visited := make([]bool, n)
+	visited[source] = true
+	queue := []int{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == destination {
+			return true
+		}
+		for _, next := range edges[node] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package first_bad_version
+
+// FirstBadVersion finds the first of the versions 1 to n that is bad, given
+// that every version after a bad version is bad too
+//
+//leetcode:judge SetupJudge
+//leetcode:calls 31
+//leetcode:difficulty easy
+//leetcode:tags binary-search,interactive
+func FirstBadVersion(n int) int {
+	lo, hi := 1, n
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if isBadVersion(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
@@ -0,0 +1,33 @@
+package first_bad_version
+
+import (
+	"fmt"
+
+	"leetcodedaily/solver"
+)
+
+// isBadVersion is LeetCode's API for the problem. It reports whether a
+// version is bad.
+var isBadVersion func(version int) bool
+
+// SetupJudge backs isBadVersion with the hidden first bad version of a test
+// case
+func SetupJudge(j *solver.Judge) error {
+	n, err := j.Int("n")
+	if err != nil {
+		return err
+	}
+	bad, err := j.Int("bad")
+	if err != nil {
+		return err
+	}
+	if bad < 1 || bad > n {
+		return fmt.Errorf("bad %d is not between 1 and n = %d", bad, n)
+	}
+
+	isBadVersion = func(version int) bool {
+		j.Call("isBadVersion")
+		return version >= bad
+	}
+	return nil
+}
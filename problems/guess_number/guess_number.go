@@ -0,0 +1,23 @@
+package guess_number
+
+// GuessNumber finds the number from 1 to n picked by the judge, asking the
+// guess API whether a number is higher or lower than it
+//
+//leetcode:judge SetupJudge
+//leetcode:difficulty easy
+//leetcode:tags binary-search,interactive
+func GuessNumber(n int) int {
+	lo, hi := 1, n
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+		switch guess(mid) {
+		case 0:
+			return mid
+		case -1:
+			hi = mid - 1
+		default:
+			lo = mid + 1
+		}
+	}
+	return -1
+}
@@ -0,0 +1,41 @@
+package guess_number
+
+import (
+	"fmt"
+	"math/bits"
+
+	"leetcodedaily/solver"
+)
+
+// guess is LeetCode's API for the problem. It returns -1 if num is higher
+// than the picked number, 1 if it is lower and 0 if it is the number.
+var guess func(num int) int
+
+// SetupJudge backs guess with the hidden pick of a test case, and allows as
+// many calls as a binary search over 1 to n needs
+func SetupJudge(j *solver.Judge) error {
+	n, err := j.Int("n")
+	if err != nil {
+		return err
+	}
+	pick, err := j.Int("pick")
+	if err != nil {
+		return err
+	}
+	if pick < 1 || pick > n {
+		return fmt.Errorf("pick %d is not between 1 and n = %d", pick, n)
+	}
+
+	j.SetBudget(bits.Len(uint(n)))
+	guess = func(num int) int {
+		j.Call("guess")
+		switch {
+		case num > pick:
+			return -1
+		case num < pick:
+			return 1
+		}
+		return 0
+	}
+	return nil
+}
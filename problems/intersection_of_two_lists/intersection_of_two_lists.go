@@ -0,0 +1,31 @@
+package intersection_of_two_lists
+
+import "leetcodedaily/structures"
+
+// ListNode is the linked list node used by LeetCode
+type ListNode = structures.ListNode
+
+// GetIntersectionNode returns the node at which two linked lists intersect,
+// or nil if they don't
+//
+//leetcode:encoding intersection
+//leetcode:difficulty easy
+//leetcode:tags linked-list,two-pointers
+func GetIntersectionNode(headA, headB *ListNode) *ListNode {
+	// Both pointers walk both lists, so they meet at the intersection or at
+	// the end after the same number of steps
+	a, b := headA, headB
+	for a != b {
+		if a == nil {
+			a = headB
+		} else {
+			a = a.Next
+		}
+		if b == nil {
+			b = headA
+		} else {
+			b = b.Next
+		}
+	}
+	return a
+}
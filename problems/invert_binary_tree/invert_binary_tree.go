@@ -0,0 +1,20 @@
+package invert_binary_tree
+
+import "leetcodedaily/structures"
+
+// TreeNode is the binary tree node used by LeetCode
+type TreeNode = structures.TreeNode
+
+// InvertTree mirrors a binary tree by swapping the children of every node and
+// returns its root
+//
+//leetcode:difficulty easy
+//leetcode:tags tree,depth-first-search,breadth-first-search,binary-tree
+func InvertTree(root *TreeNode) *TreeNode {
+	if root == nil {
+		return nil
+	}
+
+	root.Left, root.Right = InvertTree(root.Right), InvertTree(root.Left)
+	return root
+}
@@ -0,0 +1,27 @@
+package linked_list_cycle_ii
+
+import "leetcodedaily/structures"
+
+// ListNode is the linked list node used by LeetCode
+type ListNode = structures.ListNode
+
+// DetectCycle returns the node where the cycle of a linked list begins, or nil
+// if the list has no cycle
+//
+//leetcode:encoding cycle
+//leetcode:difficulty medium
+//leetcode:tags linked-list,two-pointers
+func DetectCycle(head *ListNode) *ListNode {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+		if slow == fast {
+			// The start of the cycle is as far from the head as from the
+			// meeting point
+			for slow = head; slow != fast; slow, fast = slow.Next, fast.Next {
+			}
+			return slow
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package lru_cache
+
+import "container/list"
+
+// LRUCache is a cache of fixed capacity that evicts the least recently used
+// key when it is full
+type LRUCache struct {
+	capacity int
+	order    *list.List
+	entries  map[int]*list.Element
+}
+
+// entry is a key-value pair of the cache, kept in order of use
+type entry struct {
+	key, value int
+}
+
+// Constructor creates a cache that holds up to capacity keys
+//
+//leetcode:difficulty medium
+//leetcode:tags hash-table,linked-list,design,doubly-linked-list
+func Constructor(capacity int) LRUCache {
+	return LRUCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[int]*list.Element),
+	}
+}
+
+// Get returns the value of a key, or -1 if it is not in the cache
+func (c *LRUCache) Get(key int) int {
+	elem, ok := c.entries[key]
+	if !ok {
+		return -1
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*entry).value
+}
+
+// Put sets the value of a key, evicting the least recently used key if the
+// cache is full
+func (c *LRUCache) Put(key int, value int) {
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*entry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() == c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).key)
+	}
+	c.entries[key] = c.order.PushFront(&entry{key: key, value: value})
+}
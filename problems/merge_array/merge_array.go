@@ -1,6 +1,10 @@
 package merge_array
 
 // Merge merges nums2 into nums1 in-place
+//
+//leetcode:inplace nums1
+//leetcode:difficulty easy
+//leetcode:tags array,two-pointers,sorting
 func Merge(nums1 []int, m int, nums2 []int, n int) {
 	if n == 0 {
 		return
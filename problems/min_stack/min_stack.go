@@ -0,0 +1,42 @@
+package min_stack
+
+// MinStack is a stack that returns its minimum element in constant time
+type MinStack struct {
+	values []int
+	// mins holds the minimum of the values up to each position
+	mins []int
+}
+
+// Constructor creates an empty stack
+//
+//leetcode:difficulty medium
+//leetcode:tags stack,design
+func Constructor() MinStack {
+	return MinStack{}
+}
+
+// Push pushes a value onto the stack
+func (s *MinStack) Push(val int) {
+	min := val
+	if n := len(s.mins); n > 0 && s.mins[n-1] < min {
+		min = s.mins[n-1]
+	}
+	s.values = append(s.values, val)
+	s.mins = append(s.mins, min)
+}
+
+// Pop removes the top value
+func (s *MinStack) Pop() {
+	s.values = s.values[:len(s.values)-1]
+	s.mins = s.mins[:len(s.mins)-1]
+}
+
+// Top returns the top value
+func (s *MinStack) Top() int {
+	return s.values[len(s.values)-1]
+}
+
+// GetMin returns the minimum value
+func (s *MinStack) GetMin() int {
+	return s.mins[len(s.mins)-1]
+}
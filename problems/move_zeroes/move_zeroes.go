@@ -0,0 +1,23 @@
+package move_zeroes
+
+// MoveZeroes moves all zeroes in nums to the end while keeping the relative
+// order of the non-zero elements
+//
+//leetcode:inplace nums
+//leetcode:difficulty easy
+//leetcode:tags array,two-pointers
+func MoveZeroes(nums []int) {
+	// Compact the non-zero elements at the front
+	slow := 0
+	for _, num := range nums {
+		if num != 0 {
+			nums[slow] = num
+			slow++
+		}
+	}
+
+	// Fill the rest with zeroes
+	for i := slow; i < len(nums); i++ {
+		nums[i] = 0
+	}
+}
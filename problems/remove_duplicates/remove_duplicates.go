@@ -0,0 +1,24 @@
+package remove_duplicates
+
+// RemoveDuplicates removes the duplicates from the sorted array nums in place
+// and returns the number of unique elements
+//
+//leetcode:inplace nums
+//leetcode:difficulty easy
+//leetcode:tags array,two-pointers
+func RemoveDuplicates(nums []int) int {
+	if len(nums) == 0 {
+		return 0
+	}
+
+	// slow is the index of the last unique element
+	slow := 0
+	for fast := 1; fast < len(nums); fast++ {
+		if nums[fast] != nums[slow] {
+			slow++
+			nums[slow] = nums[fast]
+		}
+	}
+
+	return slow + 1
+}
@@ -1,6 +1,11 @@
 package remove_element
 
 // RemoveElement removes all instances of val from nums and returns the new length
+//
+//leetcode:inplace nums
+//leetcode:compare unordered
+//leetcode:difficulty easy
+//leetcode:tags array,two-pointers
 func RemoveElement(nums []int, val int) int {
 	i := 0
 	for i < len(nums) {
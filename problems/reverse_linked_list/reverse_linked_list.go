@@ -0,0 +1,18 @@
+package reverse_linked_list
+
+import "leetcodedaily/structures"
+
+// ListNode is the linked list node used by LeetCode
+type ListNode = structures.ListNode
+
+// ReverseList reverses a singly linked list and returns its new head
+//
+//leetcode:difficulty easy
+//leetcode:tags linked-list,recursion
+func ReverseList(head *ListNode) *ListNode {
+	var prev *ListNode
+	for head != nil {
+		head.Next, prev, head = prev, head, head.Next
+	}
+	return prev
+}
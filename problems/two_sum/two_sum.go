@@ -2,6 +2,10 @@ package two_sum
 
 // TwoSum finds two numbers in the array that add up to the target
 // and returns their indices
+//
+//leetcode:compare unordered
+//leetcode:difficulty easy
+//leetcode:tags array,hash-table
 func TwoSum(nums []int, target int) []int {
 	// Create a map to store values and their indices
 	numMap := make(map[int]int)
@@ -0,0 +1,41 @@
+package valid_palindrome
+
+// IsPalindrome reports whether s reads the same forward and backward once
+// uppercase letters are lowered and non-alphanumeric characters removed
+//
+//leetcode:difficulty easy
+//leetcode:tags two-pointers,string
+func IsPalindrome(s string) bool {
+	i, j := 0, len(s)-1
+	for i < j {
+		// Skip the characters that don't count
+		if !isAlphanumeric(s[i]) {
+			i++
+			continue
+		}
+		if !isAlphanumeric(s[j]) {
+			j--
+			continue
+		}
+
+		if toLower(s[i]) != toLower(s[j]) {
+			return false
+		}
+		i++
+		j--
+	}
+	return true
+}
+
+// isAlphanumeric reports whether an ASCII character is a letter or a digit
+func isAlphanumeric(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// toLower lowers an ASCII letter
+func toLower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
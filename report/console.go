@@ -0,0 +1,139 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// Console reports results in the human-readable console format, with the
+// details of each case, the totals of each problem and a summary
+type Console struct {
+	w       io.Writer
+	options Options
+	// problem counts the results of the current problem
+	problem Summary
+}
+
+// NewConsole creates a console reporter that writes to w
+func NewConsole(w io.Writer, options Options) *Console {
+	return &Console{w: w, options: options}
+}
+
+// StartProblem implements runner.Listener
+func (c *Console) StartProblem(problemType solver.ProblemType) {
+	if !c.options.Quiet {
+		fmt.Fprintf(c.w, "\n=== Testing Problem: %s ===\n\n", problemType)
+	}
+	c.problem = Summary{}
+}
+
+// CaseDone implements runner.Listener
+func (c *Console) CaseDone(result runner.Result) {
+	c.problem.Add(result)
+	if c.options.Quiet && !result.Status.Failed() {
+		return
+	}
+
+	if c.options.Quiet {
+		fmt.Fprintf(c.w, "--- %s/%s\n", result.Problem, result.Case)
+	}
+	if c.options.Verbose && result.Input != "" {
+		fmt.Fprintf(c.w, "   Input:    %s\n", result.Input)
+	}
+	c.printResult(result)
+}
+
+// EndProblem implements runner.Listener
+func (c *Console) EndProblem(problemType solver.ProblemType) {
+	if c.options.Quiet {
+		return
+	}
+	if c.problem.Skipped > 0 {
+		fmt.Fprintf(c.w, "\nResults for %s: %d passed, %d failed, %d skipped\n", problemType, c.problem.Passed, c.problem.Failed, c.problem.Skipped)
+	} else {
+		fmt.Fprintf(c.w, "\nResults for %s: %d passed, %d failed\n", problemType, c.problem.Passed, c.problem.Failed)
+	}
+}
+
+// Finish implements Reporter
+func (c *Console) Finish(summary Summary) {
+	fmt.Fprintf(c.w, "\n=== Summary ===\n")
+	fmt.Fprintf(c.w, "Total: %d tests\n", summary.Total())
+	fmt.Fprintf(c.w, "Passed: %d tests\n", summary.Passed)
+	if summary.ParseErrors > 0 {
+		fmt.Fprintf(c.w, "Failed: %d tests (%d test files could not be parsed)\n", summary.Failed, summary.ParseErrors)
+	} else {
+		fmt.Fprintf(c.w, "Failed: %d tests\n", summary.Failed)
+	}
+	fmt.Fprintf(c.w, "Skipped: %d tests\n", summary.Skipped)
+	if summary.Stopped {
+		fmt.Fprintf(c.w, "Stopped after the first failure (-fail-fast)\n")
+	}
+}
+
+// printResult prints the details and the verdict of a test case
+func (c *Console) printResult(result runner.Result) {
+	switch result.Status {
+	case runner.StatusSkip:
+		log.Printf("⚠️ SKIP: %s (%s)", result.Case, result.Message)
+	case runner.StatusParseError:
+		log.Printf("Error parsing test file %s: %s", result.File, result.Message)
+	case runner.StatusTLE:
+		fmt.Fprintf(c.w, "   Time Limit Exceeded: %s\n", result.Message)
+		fmt.Fprintf(c.w, "⏱️ TLE: %s (stopped after %v)\n", result.Case, result.Elapsed)
+	case runner.StatusRuntimeError:
+		fmt.Fprintf(c.w, "   Runtime Error: %s\n", result.Message)
+		for _, line := range result.Stack {
+			fmt.Fprintf(c.w, "       %s\n", line)
+		}
+		fmt.Fprintf(c.w, "💥 RUNTIME ERROR: %s (%v)\n", result.Case, result.Elapsed)
+	case runner.StatusMLE:
+		if result.Actual != "" {
+			fmt.Fprintf(c.w, "   Expected: %s\n   Got:      %s\n", result.Expected, result.Actual)
+			fmt.Fprintf(c.w, "   Memory:   %s ❌\n", memoryUsage(result))
+		}
+		fmt.Fprintf(c.w, "   Memory Limit Exceeded: %s\n", result.Message)
+		fmt.Fprintf(c.w, "💾 MLE: %s (%v)\n", result.Case, result.Elapsed)
+	case runner.StatusPass:
+		fmt.Fprintf(c.w, "   Expected: %s\n   Got:      %s\n", result.Expected, result.Actual)
+		fmt.Fprintf(c.w, "   Memory:   %s\n", memoryUsage(result))
+		c.printCalls(result)
+		fmt.Fprintf(c.w, "✅ PASS: %s (%v)\n", result.Case, result.Elapsed)
+	default:
+		if result.Actual != "" {
+			c.printOutputs(result)
+			fmt.Fprintf(c.w, "   Memory:   %s\n", memoryUsage(result))
+		}
+		c.printCalls(result)
+		if result.Message != "" {
+			fmt.Fprintf(c.w, "   %s\n", result.Message)
+		}
+		fmt.Fprintf(c.w, "❌ FAIL: %s (%v)\n", result.Case, result.Elapsed)
+	}
+}
+
+// printCalls prints the API calls of an interactive problem, e.g.
+// "Calls:    4 (budget 31)"
+func (c *Console) printCalls(result runner.Result) {
+	if result.Calls == 0 && result.CallBudget == 0 {
+		return
+	}
+	usage := fmt.Sprintf("%d", result.Calls)
+	if result.CallBudget > 0 {
+		usage += fmt.Sprintf(" (budget %d)", result.CallBudget)
+	}
+	fmt.Fprintf(c.w, "   Calls:    %s\n", usage)
+}
+
+// memoryUsage formats the allocations of a test case, e.g. "1.5KB in 12 allocs (limit 64MB)"
+func memoryUsage(result runner.Result) string {
+	usage := fmt.Sprintf("%v in %d allocs", solver.ByteSize(result.AllocBytes), result.Allocs)
+	if result.MemoryLimit > 0 {
+		usage += fmt.Sprintf(" (limit %v)", result.MemoryLimit)
+	}
+	return usage
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// ANSI escape codes used to highlight differences
+const (
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// outputIndent is the width of the labels in front of the outputs, e.g. "   Got:      "
+const outputIndent = 13
+
+// printOutputs prints the expected and the actual output of a wrong answer
+// and where they differ. The differing elements are colored if the console
+// supports it, and marked with carets otherwise.
+func (c *Console) printOutputs(result runner.Result) {
+	d := result.Diff
+	if d == nil {
+		fmt.Fprintf(c.w, "   Expected: %s\n   Got:      %s ❌\n", result.Expected, result.Actual)
+		return
+	}
+
+	if c.options.Color {
+		fmt.Fprintf(c.w, "   Expected: %s\n", highlight(result.Expected, d.ExpectedSpan, colorGreen))
+		fmt.Fprintf(c.w, "   Got:      %s ❌\n", highlight(result.Actual, d.ActualSpan, colorRed))
+	} else {
+		fmt.Fprintf(c.w, "   Expected: %s\n", result.Expected)
+		if d.ActualSpan == nil && d.ExpectedSpan != nil {
+			fmt.Fprintf(c.w, "%s\n", caret(result.Expected, d.ExpectedSpan))
+		}
+		fmt.Fprintf(c.w, "   Got:      %s ❌\n", result.Actual)
+		if d.ActualSpan != nil {
+			fmt.Fprintf(c.w, "%s\n", caret(result.Actual, d.ActualSpan))
+		}
+	}
+
+	for i, line := range describeDiff(d) {
+		if i == 0 {
+			fmt.Fprintf(c.w, "   Diff:     %s\n", line)
+		} else {
+			fmt.Fprintf(c.w, "%s%s\n", strings.Repeat(" ", outputIndent), line)
+		}
+	}
+}
+
+// describeDiff explains a difference, e.g. "first difference at [1][2]:
+// expected 5, got 6", followed by the first length mismatch if any
+func describeDiff(d *solver.Diff) []string {
+	var line string
+	switch {
+	case d.Actual == "":
+		line = fmt.Sprintf("expected %s, got nothing", d.Expected)
+	case d.Expected == "":
+		line = fmt.Sprintf("expected nothing, got %s", d.Actual)
+	default:
+		line = fmt.Sprintf("expected %s, got %s", d.Expected, d.Actual)
+	}
+	where := d.Param + solver.FormatPath(d.Path)
+	if d.Operation != "" && len(d.Path) > 0 {
+		where = fmt.Sprintf("operation %d (%s)%s", d.Path[0], d.Operation, solver.FormatPath(d.Path[1:]))
+	}
+	if where != "" {
+		line = fmt.Sprintf("first difference at %s: %s", where, line)
+	}
+	if d.Sorted {
+		line += " (after sorting)"
+	}
+	lines := []string{line}
+
+	if d.Length != nil {
+		list := d.Param + solver.FormatPath(d.Length.Path)
+		if list == "" {
+			list = "the output"
+		}
+		lines = append(lines, fmt.Sprintf("length of %s: expected %d elements, got %d", list, d.Length.Expected, d.Length.Actual))
+	}
+	return lines
+}
+
+// highlight colors the span of a formatted output
+func highlight(text string, span *[2]int, color string) string {
+	if span == nil || span[1] <= span[0] || span[1] > len(text) {
+		return text
+	}
+	return text[:span[0]] + color + text[span[0]:span[1]] + colorReset + text[span[1]:]
+}
+
+// caret returns a line that marks the span of a formatted output printed
+// after the output labels. Spans always lie within the text they refer to.
+func caret(text string, span *[2]int) string {
+	column := outputIndent + utf8.RuneCountInString(text[:span[0]])
+	width := utf8.RuneCountInString(text[span[0]:span[1]])
+	if width == 0 {
+		width = 1
+	}
+	return strings.Repeat(" ", column) + strings.Repeat("^", width)
+}
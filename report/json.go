@@ -0,0 +1,34 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// JSON reports every result as a line of JSON, for tools that process the
+// results as they arrive
+type JSON struct {
+	encoder *json.Encoder
+}
+
+// NewJSON creates a JSON lines reporter that writes to w
+func NewJSON(w io.Writer) *JSON {
+	return &JSON{encoder: json.NewEncoder(w)}
+}
+
+// StartProblem implements runner.Listener
+func (j *JSON) StartProblem(problemType solver.ProblemType) {}
+
+// CaseDone implements runner.Listener
+func (j *JSON) CaseDone(result runner.Result) {
+	j.encoder.Encode(result)
+}
+
+// EndProblem implements runner.Listener
+func (j *JSON) EndProblem(problemType solver.ProblemType) {}
+
+// Finish implements Reporter
+func (j *JSON) Finish(summary Summary) {}
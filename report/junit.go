@@ -0,0 +1,151 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// JUnit reports the results as a JUnit XML document, which CI servers
+// understand. Every problem is a test suite and every case a test case.
+// Wrong answers are failures; TLE, MLE, runtime and parse errors are errors.
+// The document is written when the run finishes.
+type JUnit struct {
+	w      io.Writer
+	suites junitSuites
+}
+
+// junitSuites is the root element of a JUnit XML document
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Errors   int          `xml:"errors,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     junitTime    `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+// junitSuite holds the cases of a problem
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     junitTime   `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+// junitCase is a single test case
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Time      junitTime     `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitProblem `xml:"skipped,omitempty"`
+}
+
+// junitProblem describes why a case failed, errored or was skipped
+type junitProblem struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Details string `xml:",cdata"`
+}
+
+// junitTime is a duration in seconds, written with a fixed number of decimals
+type junitTime float64
+
+// MarshalXMLAttr implements xml.MarshalerAttr
+func (t junitTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strconv.FormatFloat(float64(t), 'f', 6, 64)}, nil
+}
+
+// NewJUnit creates a JUnit XML reporter that writes to w
+func NewJUnit(w io.Writer) *JUnit {
+	return &JUnit{w: w}
+}
+
+// StartProblem implements runner.Listener
+func (j *JUnit) StartProblem(problemType solver.ProblemType) {
+	j.suites.Suites = append(j.suites.Suites, junitSuite{Name: string(problemType)})
+}
+
+// CaseDone implements runner.Listener
+func (j *JUnit) CaseDone(result runner.Result) {
+	suite := &j.suites.Suites[len(j.suites.Suites)-1]
+	c := junitCase{
+		Name:      result.Case,
+		ClassName: string(result.Problem),
+		File:      result.File,
+		Time:      junitTime(result.Elapsed.Seconds()),
+	}
+
+	problem := &junitProblem{
+		Message: result.Message,
+		Type:    string(result.Status),
+		Details: details(result),
+	}
+	switch {
+	case result.Status == runner.StatusPass:
+	case result.Status == runner.StatusSkip:
+		c.Skipped = &junitProblem{Message: result.Message}
+		suite.Skipped++
+	case result.Status == runner.StatusFail:
+		c.Failure = problem
+		suite.Failures++
+	default:
+		c.Error = problem
+		suite.Errors++
+	}
+
+	suite.Tests++
+	suite.Time += c.Time
+	suite.Cases = append(suite.Cases, c)
+}
+
+// EndProblem implements runner.Listener
+func (j *JUnit) EndProblem(problemType solver.ProblemType) {}
+
+// Finish implements Reporter
+func (j *JUnit) Finish(summary Summary) {
+	for _, suite := range j.suites.Suites {
+		j.suites.Tests += suite.Tests
+		j.suites.Failures += suite.Failures
+		j.suites.Errors += suite.Errors
+		j.suites.Skipped += suite.Skipped
+		j.suites.Time += suite.Time
+	}
+
+	io.WriteString(j.w, xml.Header)
+	encoder := xml.NewEncoder(j.w)
+	encoder.Indent("", "  ")
+	encoder.Encode(j.suites)
+	io.WriteString(j.w, "\n")
+}
+
+// details formats the input, the expected and the actual output and the
+// stack trace of a result, as far as they are known
+func details(result runner.Result) string {
+	var b strings.Builder
+	if result.Input != "" {
+		fmt.Fprintf(&b, "Input:    %s\n", result.Input)
+	}
+	if result.Expected != "" {
+		fmt.Fprintf(&b, "Expected: %s\n", result.Expected)
+	}
+	if result.Actual != "" {
+		fmt.Fprintf(&b, "Got:      %s\n", result.Actual)
+	}
+	for _, line := range result.Stack {
+		fmt.Fprintf(&b, "%s\n", line)
+	}
+	return b.String()
+}
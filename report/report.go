@@ -0,0 +1,120 @@
+// Package report formats the results of a run for people and for tools such
+// as CI servers and dashboards
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// Reporter receives the results of a run, in order, and writes them in its
+// format. Finish is called once after the last result.
+type Reporter interface {
+	runner.Listener
+	// Finish is called with the totals of the run
+	Finish(summary Summary)
+}
+
+// Summary counts the results of a run
+type Summary struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+	// ParseErrors counts the test files that could not be parsed, which are
+	// also counted as failed
+	ParseErrors int `json:"parse_errors"`
+	// Stopped is set if the run was stopped early by fail-fast
+	Stopped bool `json:"stopped"`
+}
+
+// Total returns the number of results
+func (s Summary) Total() int {
+	return s.Passed + s.Failed + s.Skipped
+}
+
+// Add counts a result
+func (s *Summary) Add(result runner.Result) {
+	switch {
+	case result.Status == runner.StatusSkip:
+		s.Skipped++
+	case result.Status.Failed():
+		s.Failed++
+		if result.Status == runner.StatusParseError {
+			s.ParseErrors++
+		}
+	default:
+		s.Passed++
+	}
+}
+
+// Options configure the reporters that support them
+type Options struct {
+	// Quiet only reports failing cases and the summary on the console
+	Quiet bool
+	// Verbose also reports the input of each case on the console
+	Verbose bool
+	// Color highlights differences on the console with ANSI escape codes
+	Color bool
+}
+
+// constructors creates the reporter of each format
+var constructors = map[string]func(w io.Writer, options Options) Reporter{
+	"text":  func(w io.Writer, options Options) Reporter { return NewConsole(w, options) },
+	"json":  func(w io.Writer, options Options) Reporter { return NewJSON(w) },
+	"junit": func(w io.Writer, options Options) Reporter { return NewJUnit(w) },
+	"tap":   func(w io.Writer, options Options) Reporter { return NewTAP(w) },
+}
+
+// Formats returns the names of the supported formats
+func Formats() []string {
+	formats := make([]string, 0, len(constructors))
+	for format := range constructors {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// New creates the reporter for a format, which is one of Formats
+func New(format string, w io.Writer, options Options) (Reporter, error) {
+	constructor, ok := constructors[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown report format %q (expected one of %v)", format, Formats())
+	}
+	return constructor(w, options), nil
+}
+
+// Run runs the jobs with the runner, passes the results to the reporter and
+// returns the totals
+func Run(testRunner *runner.Runner, jobs []runner.Job, reporter Reporter) Summary {
+	c := &counter{reporter: reporter}
+	c.summary.Stopped = !testRunner.Run(jobs, c)
+	reporter.Finish(c.summary)
+	return c.summary
+}
+
+// counter counts the results passed on to a reporter
+type counter struct {
+	reporter Reporter
+	summary  Summary
+}
+
+// StartProblem implements runner.Listener
+func (c *counter) StartProblem(problemType solver.ProblemType) {
+	c.reporter.StartProblem(problemType)
+}
+
+// CaseDone implements runner.Listener
+func (c *counter) CaseDone(result runner.Result) {
+	c.summary.Add(result)
+	c.reporter.CaseDone(result)
+}
+
+// EndProblem implements runner.Listener
+func (c *counter) EndProblem(problemType solver.ProblemType) {
+	c.reporter.EndProblem(problemType)
+}
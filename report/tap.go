@@ -0,0 +1,82 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"leetcodedaily/runner"
+	"leetcodedaily/solver"
+)
+
+// TAP reports the results in the Test Anything Protocol, version 13. The
+// plan is written at the end, since the number of cases is only known once
+// the test files are parsed. Failing cases carry a YAML block with details.
+type TAP struct {
+	w io.Writer
+	// n is the number of the last reported case
+	n int
+}
+
+// NewTAP creates a TAP reporter that writes to w
+func NewTAP(w io.Writer) *TAP {
+	fmt.Fprintln(w, "TAP version 13")
+	return &TAP{w: w}
+}
+
+// StartProblem implements runner.Listener
+func (t *TAP) StartProblem(problemType solver.ProblemType) {
+	fmt.Fprintf(t.w, "# %s\n", problemType)
+}
+
+// CaseDone implements runner.Listener
+func (t *TAP) CaseDone(result runner.Result) {
+	t.n++
+	name := fmt.Sprintf("%s/%s", result.Problem, result.Case)
+
+	switch {
+	case result.Status == runner.StatusSkip:
+		fmt.Fprintf(t.w, "ok %d - %s # SKIP %s\n", t.n, name, result.Message)
+		return
+	case !result.Status.Failed():
+		fmt.Fprintf(t.w, "ok %d - %s\n", t.n, name)
+		return
+	}
+
+	fmt.Fprintf(t.w, "not ok %d - %s\n", t.n, name)
+	fmt.Fprintf(t.w, "  ---\n")
+	t.field("status", string(result.Status))
+	t.field("message", result.Message)
+	t.field("file", result.File)
+	t.field("input", result.Input)
+	t.field("expected", result.Expected)
+	t.field("got", result.Actual)
+	if len(result.Stack) > 0 {
+		fmt.Fprintf(t.w, "  stack:\n")
+		for _, line := range result.Stack {
+			fmt.Fprintf(t.w, "    - %s\n", strconv.Quote(strings.Join(strings.Fields(line), " ")))
+		}
+	}
+	fmt.Fprintf(t.w, "  elapsed_ms: %.3f\n", float64(result.Elapsed.Microseconds())/1000)
+	fmt.Fprintf(t.w, "  ...\n")
+}
+
+// field writes a quoted field of a YAML block, if it is set
+func (t *TAP) field(name, value string) {
+	if value != "" {
+		fmt.Fprintf(t.w, "  %s: %s\n", name, strconv.Quote(value))
+	}
+}
+
+// EndProblem implements runner.Listener
+func (t *TAP) EndProblem(problemType solver.ProblemType) {}
+
+// Finish implements Reporter
+func (t *TAP) Finish(summary Summary) {
+	if summary.Stopped {
+		fmt.Fprintf(t.w, "Bail out! Stopped after the first failure\n")
+		return
+	}
+	fmt.Fprintf(t.w, "1..%d\n", t.n)
+}
@@ -0,0 +1,111 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// BenchResult is the outcome of benchmarking a single test case
+type BenchResult struct {
+	Problem solver.ProblemType `json:"problem"`
+	Case    string             `json:"case"`
+	// Result is the verdict of a first run of the case. Only passing cases
+	// are benchmarked.
+	Result Result `json:"result"`
+	// N is the number of iterations the benchmark ran
+	N           int           `json:"n"`
+	NsPerOp     int64         `json:"ns_per_op"`
+	AllocsPerOp int64         `json:"allocs_per_op"`
+	BytesPerOp  int64         `json:"bytes_per_op"`
+	Total       time.Duration `json:"total_ns"`
+}
+
+// Bench benchmarks every passing case of a job in the runner process with
+// testing.Benchmark, which picks the number of iterations. Each iteration
+// includes the copy of the arguments made by the solver.
+func (r *Runner) Bench(job Job) []BenchResult {
+	problemSolver, exists := r.registry.Get(job.Problem)
+	if !exists {
+		return benchFailures(skipFiles(job.Problem, job.Files, "no solver available"))
+	}
+	parserSolver, ok := problemSolver.(solver.TestCaseParser)
+	if !ok {
+		return benchFailures(skipFiles(job.Problem, job.Files, "no parser available"))
+	}
+
+	checker := r.registry.Checker(job.Problem)
+	limits := r.limits(problemSolver)
+	interactive, _ := problemSolver.(solver.InteractiveProblem)
+	abandoned := false
+
+	var results []BenchResult
+	for _, testFile := range job.Files {
+		testCases, err := parserSolver.ParseTestCases(testFile)
+		if err != nil {
+			results = append(results, benchFailures([]Result{{
+				Problem: job.Problem,
+				Case:    testFile,
+				File:    testFile,
+				Status:  StatusParseError,
+				Message: err.Error(),
+			}})...)
+			continue
+		}
+
+		for _, testCase := range testCases {
+			// A solution abandoned after a TLE would call the judge's API
+			// set up for the next case of an interactive problem
+			if abandoned {
+				results = append(results, benchFailures([]Result{{
+					Problem: job.Problem,
+					Case:    testCase.Name,
+					File:    testFile,
+					Status:  StatusSkip,
+					Message: "skipped after a TLE of an interactive problem",
+				}})...)
+				continue
+			}
+
+			// Don't benchmark cases that fail, crash or run out of time
+			result := executeCase(problemSolver, checker, testCase, limits)
+			if result.Status == StatusTLE && interactive != nil && interactive.Interactive() {
+				abandoned = true
+			}
+			if result.Status != StatusPass {
+				results = append(results, benchFailures([]Result{result})...)
+				continue
+			}
+
+			bench := testing.Benchmark(func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					problemSolver.Solve(testCase.InputParams)
+				}
+			})
+
+			results = append(results, BenchResult{
+				Problem:     job.Problem,
+				Case:        testCase.Name,
+				Result:      result,
+				N:           bench.N,
+				NsPerOp:     bench.NsPerOp(),
+				AllocsPerOp: bench.AllocsPerOp(),
+				BytesPerOp:  bench.AllocedBytesPerOp(),
+				Total:       bench.T,
+			})
+		}
+	}
+
+	return results
+}
+
+// benchFailures wraps the results of cases that can't be benchmarked
+func benchFailures(failed []Result) []BenchResult {
+	results := make([]BenchResult, len(failed))
+	for i, result := range failed {
+		results[i] = BenchResult{Problem: result.Problem, Case: result.Case, Result: result}
+	}
+	return results
+}
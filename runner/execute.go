@@ -0,0 +1,146 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// executeCase runs a test case in the current process and checks the output
+// with the given checker. A solution that runs longer than the time limit is
+// reported as TLE, and one that allocates more than the memory limit as MLE.
+// Zero limits mean no limit.
+//
+// Allocations are measured with runtime.MemStats around the call, so they
+// include the copy of the arguments made by the solver and are only exact
+// when no other case is running in the process.
+func executeCase(problemSolver solver.Problem, checker solver.Checker, testCase solver.TestCase, limits solver.Limits) Result {
+	result := Result{
+		Problem:  testCase.ProblemType,
+		Case:     testCase.Name,
+		File:     testCase.FilePath,
+		Input:    testCase.FormatInput(),
+		Expected: fmt.Sprintf("%v", testCase.ExpectedOutput),
+	}
+
+	type solveResult struct {
+		output  interface{}
+		err     error
+		elapsed time.Duration
+		// allocBytes and allocs are the allocations made during the call
+		allocBytes uint64
+		allocs     uint64
+		// panicked is set when the solution panicked with panicValue
+		panicked   bool
+		panicValue interface{}
+		stack      []string
+	}
+
+	// Call the solver in its own goroutine so the run can move on when the
+	// time limit is exceeded. The goroutine of a solution that never returns
+	// is abandoned. Panics are recovered so they only fail this test case.
+	done := make(chan solveResult, 1)
+	start := time.Now()
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- solveResult{
+					elapsed:    time.Since(start),
+					panicked:   true,
+					panicValue: r,
+					stack:      solutionStack(),
+				}
+			}
+		}()
+
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		begin := time.Now()
+		output, err := problemSolver.Solve(testCase.InputParams)
+		elapsed := time.Since(begin)
+		runtime.ReadMemStats(&after)
+
+		done <- solveResult{
+			output:     output,
+			err:        err,
+			elapsed:    elapsed,
+			allocBytes: after.TotalAlloc - before.TotalAlloc,
+			allocs:     after.Mallocs - before.Mallocs,
+		}
+	}()
+
+	var deadline <-chan time.Time
+	if limits.Time > 0 {
+		timer := time.NewTimer(limits.Time)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	var solved solveResult
+	select {
+	case solved = <-done:
+	case <-deadline:
+		result.Status = StatusTLE
+		result.Message = fmt.Sprintf("no result after %v", limits.Time)
+		result.Elapsed = time.Since(start)
+		return result
+	}
+
+	result.Elapsed = solved.elapsed
+
+	if solved.panicked {
+		result.Status = StatusRuntimeError
+		result.Message = fmt.Sprintf("panic: %v", solved.panicValue)
+		result.Stack = solved.stack
+		return result
+	}
+
+	var budgetErr *solver.BudgetError
+	if errors.As(solved.err, &budgetErr) {
+		result.Status = StatusFail
+		result.Message = budgetErr.Error()
+		result.Calls = budgetErr.Budget + 1
+		result.CallBudget = budgetErr.Budget
+		return result
+	}
+	if solved.err != nil {
+		result.Status = StatusFail
+		result.Message = fmt.Sprintf("error solving problem: %v", solved.err)
+		return result
+	}
+
+	// Interactive problems are judged on the output alone
+	if judged, ok := solved.output.(solver.JudgedOutput); ok {
+		solved.output = judged.Output
+		result.Calls = judged.Calls
+		result.CallBudget = judged.Budget
+	}
+
+	result.Actual = fmt.Sprintf("%v", solved.output)
+	result.AllocBytes = solved.allocBytes
+	result.Allocs = solved.allocs
+	result.MemoryLimit = limits.Memory
+
+	if limits.Memory > 0 && solved.allocBytes > uint64(limits.Memory) {
+		result.Status = StatusMLE
+		result.Message = fmt.Sprintf("allocated %v, limit %v", solver.ByteSize(solved.allocBytes), limits.Memory)
+		return result
+	}
+
+	// Check the output against the expected output with the problem's checker
+	verdict := checker.Check(testCase.InputParams, testCase.ExpectedOutput, solved.output)
+	result.Message = verdict.Message
+	if verdict.OK {
+		result.Status = StatusPass
+	} else {
+		result.Status = StatusFail
+		if differ, ok := checker.(solver.Differ); ok {
+			result.Diff = differ.Diff(testCase.ExpectedOutput, solved.output)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,209 @@
+package runner
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"leetcodedaily/solver"
+)
+
+// Filter selects the problems and the cases of a run. Problems and test
+// files are selected before any test file is parsed; only patterns that
+// name single examples of a file, such as "test1#2", are matched after
+// parsing. Empty fields select everything.
+type Filter struct {
+	// Problems are glob patterns for problem names, such as "two_*"
+	Problems []string
+	// Cases are glob patterns for case names. A pattern matches a case by its
+	// name ("test1.txt#2"), its file ("test1.txt" or "test1") or its file and
+	// example ("test1#2").
+	Cases []string
+	// Tags select the problems with any of the tags
+	Tags []string
+	// Difficulties select the problems with any of the difficulties
+	Difficulties []solver.Difficulty
+	// Exclude are glob patterns for problems, or for cases as
+	// "<problem>/<case>", that are left out
+	Exclude []string
+	// Only restricts every problem to the named cases, such as the cases that
+	// failed in the last run. Problems that aren't in the map are left out.
+	// Nil means no restriction.
+	Only map[solver.ProblemType][]string
+}
+
+// ValidatePatterns reports malformed glob patterns in the filter
+func (f Filter) ValidatePatterns() error {
+	for _, patterns := range [][]string{f.Problems, f.Cases, f.Exclude} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// selectJobs applies the filter to the jobs, leaving out the problems and
+// test files it doesn't select
+func (r *Runner) selectJobs(jobs []Job) []Job {
+	var selected []Job
+	for _, job := range jobs {
+		if !r.selectProblem(job.Problem) {
+			continue
+		}
+
+		var files []string
+		for _, file := range job.Files {
+			if r.selectFile(job.Problem, file) {
+				files = append(files, file)
+			}
+		}
+		if len(files) > 0 {
+			selected = append(selected, Job{Problem: job.Problem, Files: files})
+		}
+	}
+	return selected
+}
+
+// selectProblem reports whether the filter selects a problem by its name,
+// tags and difficulty
+func (r *Runner) selectProblem(problemType solver.ProblemType) bool {
+	f := r.options.Filter
+	name := string(problemType)
+
+	if len(f.Problems) > 0 && !matchAny(f.Problems, name) {
+		return false
+	}
+	if matchAny(f.Exclude, name) {
+		return false
+	}
+	if f.Only != nil && len(f.Only[problemType]) == 0 {
+		return false
+	}
+	if len(f.Tags) == 0 && len(f.Difficulties) == 0 {
+		return true
+	}
+
+	// Tags and difficulties are declared by the problem's solver
+	problemSolver, _ := r.registry.Get(problemType)
+	tagged, ok := problemSolver.(solver.TaggedProblem)
+	if !ok {
+		return false
+	}
+	if len(f.Difficulties) > 0 && !slices.Contains(f.Difficulties, tagged.Difficulty()) {
+		return false
+	}
+	if len(f.Tags) > 0 && !hasAnyTag(tagged.Tags(), f.Tags) {
+		return false
+	}
+	return true
+}
+
+// selectFile reports whether the filter may select cases of a test file. A
+// file is kept if any of its cases could match, which is decided for every
+// case once the file is parsed.
+func (r *Runner) selectFile(problemType solver.ProblemType, file string) bool {
+	f := r.options.Filter
+	base := filepath.Base(file)
+
+	if f.Only != nil && !fileListed(f.Only[problemType], base) {
+		return false
+	}
+	for _, pattern := range f.Exclude {
+		problemPattern, filePattern, ok := strings.Cut(pattern, "/")
+		if ok && !strings.Contains(filePattern, "#") &&
+			matchPattern(problemPattern, string(problemType)) && matchFileName(filePattern, base) {
+			return false
+		}
+	}
+	if len(f.Cases) == 0 {
+		return true
+	}
+	for _, pattern := range f.Cases {
+		filePattern, _, _ := strings.Cut(pattern, "#")
+		if matchFileName(filePattern, base) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectCase reports whether the filter selects a parsed test case
+func (r *Runner) selectCase(problemType solver.ProblemType, testCase solver.TestCase) bool {
+	f := r.options.Filter
+	names := caseNames(testCase.FilePath, testCase.Name)
+
+	if f.Only != nil && !slices.Contains(f.Only[problemType], testCase.Name) &&
+		!slices.Contains(f.Only[problemType], filepath.Base(testCase.FilePath)) {
+		return false
+	}
+	for _, pattern := range f.Exclude {
+		problemPattern, casePattern, ok := strings.Cut(pattern, "/")
+		if ok && matchPattern(problemPattern, string(problemType)) && matchAny([]string{casePattern}, names...) {
+			return false
+		}
+	}
+	return len(f.Cases) == 0 || matchAny(f.Cases, names...)
+}
+
+// caseNames returns the names a case can be selected by: its name, its file
+// with and without the extension, and its file without the extension and
+// its example number, e.g. "test1.txt#2", "test1.txt", "test1" and "test1#2"
+func caseNames(file, name string) []string {
+	base := filepath.Base(file)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	names := []string{name, base, stem}
+	if _, example, ok := strings.Cut(name, "#"); ok {
+		names = append(names, stem+"#"+example)
+	}
+	return names
+}
+
+// fileListed reports whether any of the listed case names belongs to the
+// test file with the given base name
+func fileListed(names []string, base string) bool {
+	for _, name := range names {
+		if file, _, _ := strings.Cut(name, "#"); file == base {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFileName reports whether a pattern matches a test file by its name
+// with or without the extension
+func matchFileName(pattern, base string) bool {
+	return matchAny([]string{pattern}, base, strings.TrimSuffix(base, filepath.Ext(base)))
+}
+
+// matchAny reports whether any of the patterns matches any of the names
+func matchAny(patterns []string, names ...string) bool {
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if matchPattern(pattern, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether a glob pattern matches a name. Malformed
+// patterns match nothing; they are rejected by Filter.ValidatePatterns.
+func matchPattern(pattern, name string) bool {
+	matched, err := path.Match(pattern, name)
+	return err == nil && matched
+}
+
+// hasAnyTag reports whether any of the wanted tags is in tags
+func hasAnyTag(tags, wanted []string) bool {
+	for _, tag := range wanted {
+		if slices.Contains(tags, tag) {
+			return true
+		}
+	}
+	return false
+}
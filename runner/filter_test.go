@@ -0,0 +1,116 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+
+	"leetcodedaily/solver"
+)
+
+func TestSelectCase(t *testing.T) {
+	// The second example of test_cases/two_sum/test1.txt
+	testCase := solver.TestCase{FilePath: "test_cases/two_sum/test1.txt", Name: "test1.txt#2"}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"no filter", Filter{}, true},
+		{"file and example", Filter{Cases: []string{"test1#2"}}, true},
+		{"case name", Filter{Cases: []string{"test1.txt#2"}}, true},
+		{"file without extension", Filter{Cases: []string{"test1"}}, true},
+		{"file", Filter{Cases: []string{"test1.txt"}}, true},
+		{"glob over examples", Filter{Cases: []string{"test1#*"}}, true},
+		{"glob over files", Filter{Cases: []string{"test*#2"}}, true},
+		{"other example", Filter{Cases: []string{"test1#1"}}, false},
+		{"other file", Filter{Cases: []string{"test2"}}, false},
+		{"any of the patterns", Filter{Cases: []string{"test2", "test1#2"}}, true},
+		{"excluded example", Filter{Exclude: []string{"two_sum/test1#2"}}, false},
+		{"excluded file", Filter{Exclude: []string{"two_*/test1"}}, false},
+		{"exclusion of another problem", Filter{Exclude: []string{"move_zeroes/test1#2"}}, true},
+		{"exclusion of another example", Filter{Exclude: []string{"two_sum/test1#1"}}, true},
+		{"problem exclusion without a case", Filter{Exclude: []string{"test1"}}, true},
+		{"exclusion wins over selection", Filter{Cases: []string{"test1"}, Exclude: []string{"*/test1#2"}}, false},
+		{"only the case", Filter{Only: map[solver.ProblemType][]string{"two_sum": {"test1.txt#2"}}}, true},
+		{"only the file that failed to parse", Filter{Only: map[solver.ProblemType][]string{"two_sum": {"test1.txt"}}}, true},
+		{"only other cases", Filter{Only: map[solver.ProblemType][]string{"two_sum": {"test1.txt#1", "test2.txt"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(solver.NewRegistry(), Options{Filter: tt.filter})
+			if got := r.selectCase("two_sum", testCase); got != tt.want {
+				t.Errorf("selectCase(%+v) = %v, want %v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectFile(t *testing.T) {
+	const file = "test_cases/two_sum/test1.txt"
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"no filter", Filter{}, true},
+		{"example of the file", Filter{Cases: []string{"test1#2"}}, true},
+		{"file", Filter{Cases: []string{"test1.txt"}}, true},
+		{"other file", Filter{Cases: []string{"test2", "test2#1"}}, false},
+		{"excluded file", Filter{Exclude: []string{"two_sum/test1"}}, false},
+		{"excluded example", Filter{Exclude: []string{"two_sum/test1#2"}}, true},
+		{"exclusion of another problem", Filter{Exclude: []string{"move_zeroes/test1"}}, true},
+		{"only a case of the file", Filter{Only: map[solver.ProblemType][]string{"two_sum": {"test1.txt#2"}}}, true},
+		{"only cases of other files", Filter{Only: map[solver.ProblemType][]string{"two_sum": {"test2.txt#1"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(solver.NewRegistry(), Options{Filter: tt.filter})
+			if got := r.selectFile("two_sum", file); got != tt.want {
+				t.Errorf("selectFile(%+v) = %v, want %v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectJobs(t *testing.T) {
+	jobs := []Job{
+		{Problem: "two_sum", Files: []string{"two_sum/test1.txt", "two_sum/test2.txt"}},
+		{Problem: "move_zeroes", Files: []string{"move_zeroes/test1.txt"}},
+	}
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []Job
+	}{
+		{"no filter", Filter{}, jobs},
+		{"problem pattern", Filter{Problems: []string{"two_*"}}, jobs[:1]},
+		{"excluded problem", Filter{Exclude: []string{"two_sum"}}, jobs[1:]},
+		{
+			name:   "files of the selected cases",
+			filter: Filter{Cases: []string{"test2#*"}},
+			want:   []Job{{Problem: "two_sum", Files: []string{"two_sum/test2.txt"}}},
+		},
+		{
+			name:   "excluded file",
+			filter: Filter{Exclude: []string{"*/test1"}},
+			want:   []Job{{Problem: "two_sum", Files: []string{"two_sum/test2.txt"}}},
+		},
+		{
+			name:   "only the failed cases",
+			filter: Filter{Only: map[solver.ProblemType][]string{"move_zeroes": {"test1.txt#3"}}},
+			want:   jobs[1:],
+		},
+		{"nothing failed", Filter{Only: map[solver.ProblemType][]string{}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(solver.NewRegistry(), Options{Filter: tt.filter})
+			if got := r.selectJobs(jobs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectJobs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
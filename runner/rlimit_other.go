@@ -0,0 +1,16 @@
+//go:build !unix
+
+package runner
+
+import (
+	"runtime/debug"
+
+	"leetcodedaily/solver"
+)
+
+// limitMemory sets a soft memory limit for the garbage collector. There is no
+// hard cap on this platform, so a solution can still exceed the limit.
+func limitMemory(limit solver.ByteSize) error {
+	debug.SetMemoryLimit(int64(limit))
+	return nil
+}
@@ -0,0 +1,30 @@
+//go:build unix
+
+package runner
+
+import (
+	"runtime/debug"
+	"syscall"
+
+	"leetcodedaily/solver"
+)
+
+// limitMemory caps the memory of the current process. The data segment limit
+// makes allocations beyond the cap fail, which the Go runtime reports as a
+// fatal error, either "out of memory" or "cannot allocate memory" depending
+// on where the allocation fails. The address space limit can't be used, since
+// the runtime reserves far more address space than it uses. The soft memory
+// limit makes the garbage collector work harder before the cap is reached.
+func limitMemory(limit solver.ByteSize) error {
+	debug.SetMemoryLimit(int64(limit))
+	var rlimit syscall.Rlimit
+	setRlimitValue(&rlimit.Cur, limit)
+	setRlimitValue(&rlimit.Max, limit)
+	return syscall.Setrlimit(syscall.RLIMIT_DATA, &rlimit)
+}
+
+// setRlimitValue sets a field of syscall.Rlimit, which is unsigned on most
+// systems but signed on FreeBSD and DragonFly
+func setRlimitValue[T int64 | uint64](field *T, limit solver.ByteSize) {
+	*field = T(limit)
+}
@@ -0,0 +1,378 @@
+// Package runner runs the test cases of problems against their solvers and
+// produces a verdict for every case
+package runner
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// Status is the verdict of a test case
+type Status string
+
+const (
+	// StatusPass means the output was accepted
+	StatusPass Status = "PASS"
+	// StatusFail means the output was rejected or the solver returned an error
+	StatusFail Status = "FAIL"
+	// StatusTLE means the solution exceeded its time limit
+	StatusTLE Status = "TLE"
+	// StatusMLE means the solution exceeded its memory limit
+	StatusMLE Status = "MLE"
+	// StatusRuntimeError means the solution panicked or crashed its process
+	StatusRuntimeError Status = "Runtime Error"
+	// StatusParseError means the test file could not be parsed
+	StatusParseError Status = "Parse Error"
+	// StatusSkip means the problem has no solver or parser
+	StatusSkip Status = "SKIP"
+)
+
+// Failed reports whether the status counts as a failure. Skipped cases are
+// neither passed nor failed.
+func (s Status) Failed() bool {
+	return s != StatusPass && s != StatusSkip
+}
+
+// Result is the outcome of a single test case. Values are formatted as
+// strings so results can be streamed from a sandboxed child process.
+type Result struct {
+	Problem solver.ProblemType `json:"problem"`
+	Case    string             `json:"case"`
+	File    string             `json:"file"`
+	Status  Status             `json:"status"`
+	// Input is the formatted input of the case, e.g. "nums = [2 7], target = 9"
+	Input    string `json:"input,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	// Message explains the verdict, e.g. a checker message or a panic value
+	Message string `json:"message,omitempty"`
+	// Stack is the trimmed stack trace of a panic
+	Stack   []string      `json:"stack,omitempty"`
+	Elapsed time.Duration `json:"elapsed_ns"`
+	// AllocBytes and Allocs are the bytes and the number of heap objects
+	// allocated while solving the case
+	AllocBytes uint64 `json:"alloc_bytes,omitempty"`
+	Allocs     uint64 `json:"allocs,omitempty"`
+	// MemoryLimit is the limit AllocBytes was checked against, if any
+	MemoryLimit solver.ByteSize `json:"memory_limit,omitempty"`
+	// Diff locates the first difference of a wrong answer, if the checker
+	// can find it
+	Diff *solver.Diff `json:"diff,omitempty"`
+	// Calls is the number of calls the solution of an interactive problem
+	// made to the judge's API, and CallBudget the number it was allowed
+	Calls      int `json:"calls,omitempty"`
+	CallBudget int `json:"call_budget,omitempty"`
+}
+
+// Isolation selects where solutions are executed
+type Isolation string
+
+const (
+	// IsolateNone runs solutions in the runner process
+	IsolateNone Isolation = "none"
+	// IsolateProblem runs the cases of each problem in a child process
+	IsolateProblem Isolation = "problem"
+	// IsolateCase runs every case in its own child process
+	IsolateCase Isolation = "case"
+)
+
+// ParseIsolation parses the name of an isolation mode
+func ParseIsolation(name string) (Isolation, error) {
+	switch mode := Isolation(name); mode {
+	case IsolateNone, IsolateProblem, IsolateCase:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown isolation mode %q (expected none, problem or case)", name)
+	}
+}
+
+// Options configure a Runner
+type Options struct {
+	// Timeout is the time limit per test case for problems that don't
+	// declare their own. Zero means no limit.
+	Timeout time.Duration
+	// Isolation selects whether solutions run in child processes
+	Isolation Isolation
+	// MemoryLimit caps the memory of child processes. Zero means no limit.
+	MemoryLimit solver.ByteSize
+	// Workers is the number of cases, or problems in problem isolation, that
+	// run at the same time. Values below 1 mean one worker.
+	Workers int
+	// FailFast stops the run after the first failing case
+	FailFast bool
+	// Filter selects the problems and cases to run
+	Filter Filter
+}
+
+// Runner runs the test cases of registered problems
+type Runner struct {
+	registry *solver.Registry
+	options  Options
+}
+
+// New creates a runner for the problems in the registry
+func New(registry *solver.Registry, options Options) *Runner {
+	if options.Isolation == "" {
+		options.Isolation = IsolateNone
+	}
+	if options.Workers < 1 {
+		options.Workers = 1
+	}
+	return &Runner{
+		registry: registry,
+		options:  options,
+	}
+}
+
+// caseRef identifies a test case by its file and its index within the file
+type caseRef struct {
+	File  string `json:"file"`
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+}
+
+// Job is a problem and the test files to run against its solver
+type Job struct {
+	Problem solver.ProblemType
+	Files   []string
+}
+
+// Listener receives the results of a run. Its methods are called from the
+// goroutine that called Run, in the order of the jobs and of the cases
+// within each job, however many workers are used. With FailFast, no results
+// are passed after the first failing case, whose problem is still ended.
+type Listener interface {
+	// StartProblem is called before the results of a job
+	StartProblem(problemType solver.ProblemType)
+	// CaseDone is called with the result of each case
+	CaseDone(result Result)
+	// EndProblem is called after the results of a job
+	EndProblem(problemType solver.ProblemType)
+}
+
+// task is a unit of work for the worker pool. Its results are either known
+// up front, e.g. for skipped files, or produced by run.
+type task struct {
+	job     int
+	results []Result
+	run     func() []Result
+}
+
+// Run runs the test cases of the jobs on Options.Workers workers and passes
+// the results to the listener in order. Problems and cases that
+// Options.Filter doesn't select are left out. It returns false if the run
+// was stopped early by FailFast.
+func (r *Runner) Run(jobs []Job, listener Listener) bool {
+	// Jobs whose cases were all filtered out after parsing are not reported
+	var tasks []task
+	var planned []Job
+	for _, job := range r.selectJobs(jobs) {
+		jobTasks := r.plan(job)
+		if len(jobTasks) == 0 {
+			continue
+		}
+		for _, t := range jobTasks {
+			t.job = len(planned)
+			tasks = append(tasks, t)
+		}
+		planned = append(planned, job)
+	}
+	jobs = planned
+
+	// Run the tasks on the workers. Each task's results are collected
+	// separately, so cases that finish early wait for their turn.
+	type completion struct {
+		task    int
+		results []Result
+	}
+	// Completions are buffered so workers never block on a stopped run
+	pending := make(chan int)
+	completed := make(chan completion, len(tasks))
+	stop := make(chan struct{})
+	defer close(stop)
+	for w := 0; w < r.options.Workers; w++ {
+		go func() {
+			for i := range pending {
+				// The feeder may still hand out a task after the run was
+				// stopped, since its select picks any ready case
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				results := tasks[i].results
+				if tasks[i].run != nil {
+					results = tasks[i].run()
+				}
+				completed <- completion{task: i, results: results}
+			}
+		}()
+	}
+	go func() {
+		defer close(pending)
+		for i := range tasks {
+			select {
+			case pending <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	// Report the results of the tasks in order as they become available
+	done := make([][]Result, len(tasks))
+	finished := make([]bool, len(tasks))
+	next := 0
+	for i := range jobs {
+		listener.StartProblem(jobs[i].Problem)
+		for next < len(tasks) && tasks[next].job == i {
+			for !finished[next] {
+				c := <-completed
+				done[c.task] = c.results
+				finished[c.task] = true
+			}
+			for _, result := range done[next] {
+				listener.CaseDone(result)
+				if r.options.FailFast && result.Status.Failed() {
+					listener.EndProblem(jobs[i].Problem)
+					return false
+				}
+			}
+			done[next] = nil
+			next++
+		}
+		listener.EndProblem(jobs[i].Problem)
+	}
+	return true
+}
+
+// plan splits a job into tasks. Test files are parsed here, in the runner
+// process, since parsing is framework code and safe even when solutions are
+// isolated. Every case is a task, except in problem isolation, where one
+// child process runs all cases of the problem.
+func (r *Runner) plan(job Job) []task {
+	problemType := job.Problem
+
+	// Get the solver for this problem
+	problemSolver, exists := r.registry.Get(problemType)
+	if !exists {
+		return []task{{results: skipFiles(problemType, job.Files, "no solver available")}}
+	}
+
+	// Check if the solver implements the TestCaseParser interface
+	parserSolver, ok := problemSolver.(solver.TestCaseParser)
+	if !ok {
+		return []task{{results: skipFiles(problemType, job.Files, "no parser available")}}
+	}
+
+	checker := r.registry.Checker(problemType)
+	limits := r.limits(problemSolver)
+	isolation := r.isolation(problemSolver, limits)
+
+	var tasks []task
+	var refs []caseRef
+	for _, testFile := range job.Files {
+		parsed, err := parserSolver.ParseTestCases(testFile)
+		if err != nil {
+			tasks = append(tasks, task{results: []Result{{
+				Problem: problemType,
+				Case:    filepath.Base(testFile),
+				File:    testFile,
+				Status:  StatusParseError,
+				Message: err.Error(),
+			}}})
+			continue
+		}
+
+		for i, testCase := range parsed {
+			if !r.selectCase(problemType, testCase) {
+				continue
+			}
+			ref := caseRef{File: testFile, Index: i, Name: testCase.Name}
+			refs = append(refs, ref)
+
+			switch isolation {
+			case IsolateProblem:
+				// Run by the task for the whole problem below
+			case IsolateCase:
+				tasks = append(tasks, task{run: func() []Result {
+					return r.collectIsolated(problemType, []caseRef{ref}, limits)
+				}})
+			default:
+				tasks = append(tasks, task{run: func() []Result {
+					return []Result{executeCase(problemSolver, checker, testCase, limits)}
+				}})
+			}
+		}
+	}
+
+	if isolation == IsolateProblem && len(refs) > 0 {
+		tasks = append(tasks, task{run: func() []Result {
+			return r.collectIsolated(problemType, refs, limits)
+		}})
+	}
+
+	return tasks
+}
+
+// collectIsolated runs cases in child processes and returns their results
+func (r *Runner) collectIsolated(problemType solver.ProblemType, refs []caseRef, limits solver.Limits) []Result {
+	var results []Result
+	r.runIsolated(problemType, refs, limits, func(result Result) {
+		results = append(results, result)
+	})
+	return results
+}
+
+// isolation returns where the cases of a problem run. Interactive problems
+// always run in a child process: their judge's API is a package variable, and
+// a solution abandoned after a TLE must not call the API set up for a later
+// case. The child stops after a TLE, so the next case gets a fresh process.
+// Problems with a memory limit run in a child process when cases run in
+// parallel, since allocations are measured process-wide and the cases of
+// other workers would count toward the limit. The child runs the cases of the
+// problem one at a time.
+func (r *Runner) isolation(problemSolver solver.Problem, limits solver.Limits) Isolation {
+	if r.options.Isolation != IsolateNone {
+		return r.options.Isolation
+	}
+	if interactive, ok := problemSolver.(solver.InteractiveProblem); ok && interactive.Interactive() {
+		return IsolateProblem
+	}
+	if limits.Memory > 0 && r.options.Workers > 1 {
+		return IsolateProblem
+	}
+	return IsolateNone
+}
+
+// limits returns the limits for the cases of a problem. The problem's own
+// time limit overrides the default timeout.
+func (r *Runner) limits(problemSolver solver.Problem) solver.Limits {
+	var limits solver.Limits
+	if limited, ok := problemSolver.(solver.LimitedProblem); ok {
+		limits = limited.Limits()
+	}
+	if limits.Time == 0 {
+		limits.Time = r.options.Timeout
+	}
+	return limits
+}
+
+// skipFiles returns a skipped result for every test file of a problem
+func skipFiles(problemType solver.ProblemType, testFiles []string, reason string) []Result {
+	var results []Result
+	for _, testFile := range testFiles {
+		results = append(results, Result{
+			Problem: problemType,
+			Case:    filepath.Base(testFile),
+			File:    testFile,
+			Status:  StatusSkip,
+			Message: reason,
+		})
+	}
+	return results
+}
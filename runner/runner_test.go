@@ -0,0 +1,94 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"leetcodedaily/solver"
+)
+
+// examples returns a test file with a case for every sleep, whose expected
+// output is the sleep itself unless it is in wrong
+func examples(sleeps []int, wrong map[int]bool) string {
+	var b strings.Builder
+	for i, n := range sleeps {
+		expected := n
+		if wrong[i] {
+			expected = -1
+		}
+		fmt.Fprintf(&b, "Input: n = %d\nOutput: %d\n\n", n, expected)
+	}
+	return b.String()
+}
+
+// verdicts returns the problem, the case and the status of every result
+func verdicts(results []Result) []string {
+	var got []string
+	for _, result := range results {
+		got = append(got, fmt.Sprintf("%s %s %s", result.Problem, result.Case, result.Status))
+	}
+	return got
+}
+
+func TestRunOrderIsIndependentOfWorkers(t *testing.T) {
+	// Later cases sleep less, so they finish first on several workers
+	first := writeTestFile(t, "test1.txt", examples([]int{40, 30, 20, 10}, map[int]bool{1: true}))
+	second := writeTestFile(t, "test2.txt", examples([]int{25, 5, 0}, map[int]bool{2: true}))
+	jobs := []Job{
+		{Problem: "sleep", Files: []string{first, second}},
+		{Problem: "missing", Files: []string{first}},
+		{Problem: "counted", Files: []string{second}},
+	}
+
+	var sequential recorder
+	New(testRegistry(), Options{Workers: 1}).Run(jobs, &sequential)
+	var parallel recorder
+	New(testRegistry(), Options{Workers: 8}).Run(jobs, &parallel)
+
+	want := verdicts(sequential.results)
+	if len(want) != 11 {
+		t.Fatalf("sequential run reported %d results, want 11: %v", len(want), want)
+	}
+	if got := verdicts(parallel.results); !reflect.DeepEqual(got, want) {
+		t.Errorf("results with 8 workers:\n%s\nwant the order of 1 worker:\n%s",
+			strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	wantEnded := []solver.ProblemType{"sleep", "missing", "counted"}
+	if !reflect.DeepEqual(parallel.ended, wantEnded) {
+		t.Errorf("ended problems = %v, want %v", parallel.ended, wantEnded)
+	}
+}
+
+func TestRunFailFast(t *testing.T) {
+	sleeps := []int{20, 20, 20, 20, 20, 20, 20, 20, 20, 20}
+	file := writeTestFile(t, "test1.txt", examples(sleeps, map[int]bool{0: true}))
+	jobs := []Job{
+		{Problem: "counted", Files: []string{file}},
+		{Problem: "sleep", Files: []string{file}},
+	}
+
+	for _, workers := range []int{1, 2} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			calls.Store(0)
+			var rec recorder
+			completed := New(testRegistry(), Options{Workers: workers, FailFast: true}).Run(jobs, &rec)
+
+			if completed {
+				t.Error("Run reported a complete run")
+			}
+			if len(rec.results) != 1 || rec.results[0].Status != StatusFail {
+				t.Errorf("results = %v, want only the failing first case", verdicts(rec.results))
+			}
+			if !reflect.DeepEqual(rec.ended, []solver.ProblemType{"counted"}) {
+				t.Errorf("ended problems = %v, want [counted]", rec.ended)
+			}
+			// Cases already taken by a worker when the first case failed
+			// still run, but no case is started after that
+			if n := int(calls.Load()); n > 2*workers {
+				t.Errorf("%d of %d cases ran, want at most %d", n, len(sleeps), 2*workers)
+			}
+		})
+	}
+}
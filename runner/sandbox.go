@@ -0,0 +1,349 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// sandboxEnv is set in the environment of child processes. The runner binary
+// re-executes itself with it set and serves the sandbox protocol instead of
+// running the suite.
+const sandboxEnv = "LEETCODE_SANDBOX_CHILD"
+
+// killGrace is how long a child may overrun the time limit of a case before
+// it is killed. The child reports TLE itself; the kill is the fallback for
+// solutions that stop the whole process from making progress.
+const killGrace = time.Second
+
+// maxStderr is the amount of a child's stderr kept to explain a crash. The
+// Go runtime writes the reason first, followed by the goroutine dump.
+const maxStderr = 64 * 1024
+
+// sandboxRequest is sent on the stdin of a child process
+type sandboxRequest struct {
+	Problem     solver.ProblemType `json:"problem"`
+	Cases       []caseRef          `json:"cases"`
+	Limits      solver.Limits      `json:"limits"`
+	MemoryLimit solver.ByteSize    `json:"memory_limit"`
+}
+
+// sandboxEvent is streamed from a child process as one JSON line per event.
+// Exactly one of the fields is set.
+type sandboxEvent struct {
+	// Start is sent before the solution is called for a case
+	Start *caseRef `json:"start,omitempty"`
+	// Result is sent once the case has a verdict
+	Result *Result `json:"result,omitempty"`
+}
+
+// IsSandboxChild reports whether the process was started as a sandbox child
+// by a runner in an isolated mode
+func IsSandboxChild() bool {
+	return os.Getenv(sandboxEnv) == "1"
+}
+
+// RunSandboxChild serves a sandbox request from stdin and streams the events
+// to file descriptor 3. It returns the exit code of the process. The child
+// stops after a TLE, since the abandoned solution may still be running; the
+// parent starts a new child for the remaining cases.
+func RunSandboxChild(registry *solver.Registry) int {
+	var req sandboxRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox: invalid request: %v\n", err)
+		return 2
+	}
+
+	problemSolver, exists := registry.Get(req.Problem)
+	if !exists {
+		fmt.Fprintf(os.Stderr, "sandbox: no solver available for %s\n", req.Problem)
+		return 2
+	}
+	parserSolver, ok := problemSolver.(solver.TestCaseParser)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "sandbox: no parser available for %s\n", req.Problem)
+		return 2
+	}
+	checker := registry.Checker(req.Problem)
+
+	// Parse the test files before limiting the memory, which only applies to
+	// the solutions
+	parsed := make(map[string][]solver.TestCase)
+	for _, ref := range req.Cases {
+		testCases, exists := parsed[ref.File]
+		if !exists {
+			var err error
+			testCases, err = parserSolver.ParseTestCases(ref.File)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "sandbox: %v\n", err)
+				return 2
+			}
+			parsed[ref.File] = testCases
+		}
+		if ref.Index >= len(testCases) {
+			fmt.Fprintf(os.Stderr, "sandbox: %s has no case %d\n", ref.File, ref.Index)
+			return 2
+		}
+	}
+
+	if req.MemoryLimit > 0 {
+		if err := limitMemory(req.MemoryLimit); err != nil {
+			fmt.Fprintf(os.Stderr, "sandbox: cannot limit memory: %v\n", err)
+			return 2
+		}
+	}
+
+	events := json.NewEncoder(os.NewFile(3, "events"))
+	for _, ref := range req.Cases {
+		if err := events.Encode(sandboxEvent{Start: &ref}); err != nil {
+			return 2
+		}
+		result := executeCase(problemSolver, checker, parsed[ref.File][ref.Index], req.Limits)
+		if err := events.Encode(sandboxEvent{Result: &result}); err != nil {
+			return 2
+		}
+
+		if result.Status == StatusTLE {
+			return 0
+		}
+	}
+
+	return 0
+}
+
+// runIsolated runs cases in child processes, starting a new child whenever
+// one exits before all cases have a verdict
+func (r *Runner) runIsolated(problemType solver.ProblemType, refs []caseRef, limits solver.Limits, report func(Result)) {
+	for len(refs) > 0 {
+		refs = r.runChild(problemType, refs, limits, report)
+	}
+}
+
+// runChild runs cases in a single child process and returns the cases that
+// are left when the child exits. The case that was running when the child
+// crashed or was killed is reported with a verdict for the crash.
+func (r *Runner) runChild(problemType solver.ProblemType, refs []caseRef, limits solver.Limits, report func(Result)) []caseRef {
+	fail := func(ref caseRef, status Status, message string, elapsed time.Duration) {
+		report(Result{
+			Problem: problemType,
+			Case:    ref.Name,
+			File:    ref.File,
+			Status:  status,
+			Message: message,
+			Elapsed: elapsed,
+		})
+	}
+	failAll := func(message string) {
+		for _, ref := range refs {
+			fail(ref, StatusRuntimeError, message, 0)
+		}
+	}
+
+	request, err := json.Marshal(sandboxRequest{
+		Problem:     problemType,
+		Cases:       refs,
+		Limits:      limits,
+		MemoryLimit: r.options.MemoryLimit,
+	})
+	if err != nil {
+		failAll(fmt.Sprintf("cannot encode sandbox request: %v", err))
+		return nil
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		failAll(fmt.Sprintf("cannot start sandbox: %v", err))
+		return nil
+	}
+
+	eventsReader, eventsWriter, err := os.Pipe()
+	if err != nil {
+		failAll(fmt.Sprintf("cannot start sandbox: %v", err))
+		return nil
+	}
+	defer eventsReader.Close()
+
+	stderr := &headBuffer{max: maxStderr}
+	cmd := exec.Command(executable)
+	cmd.Env = append(os.Environ(), sandboxEnv+"=1")
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = stderr
+	cmd.ExtraFiles = []*os.File{eventsWriter}
+
+	err = cmd.Start()
+	eventsWriter.Close()
+	if err != nil {
+		failAll(fmt.Sprintf("cannot start sandbox: %v", err))
+		return nil
+	}
+
+	events := make(chan sandboxEvent)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(eventsReader)
+		scanner.Buffer(nil, 16*1024*1024)
+		for scanner.Scan() {
+			var event sandboxEvent
+			if err := json.Unmarshal(scanner.Bytes(), &event); err == nil {
+				events <- event
+			}
+		}
+		// Drain the pipe so the child never blocks on a full pipe
+		io.Copy(io.Discard, eventsReader)
+	}()
+
+	// Follow the child's progress. done counts the cases with a verdict and
+	// running is set while a case is being solved.
+	done := 0
+	running := false
+	killed := false
+	var started time.Time
+	var deadline <-chan time.Time
+	var timer *time.Timer
+
+	for events != nil {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				break
+			}
+			switch {
+			case event.Start != nil:
+				running = true
+				started = time.Now()
+				if limits.Time > 0 {
+					timer = time.NewTimer(limits.Time + killGrace)
+					deadline = timer.C
+				}
+			case event.Result != nil && done < len(refs):
+				report(*event.Result)
+				done++
+				running = false
+				if timer != nil {
+					timer.Stop()
+					timer, deadline = nil, nil
+				}
+			}
+		case <-deadline:
+			killed = true
+			deadline = nil
+			cmd.Process.Kill()
+		}
+	}
+	if timer != nil {
+		timer.Stop()
+	}
+
+	waitErr := cmd.Wait()
+
+	switch {
+	case done == len(refs):
+		return nil
+	case running:
+		status, message := r.crashVerdict(waitErr, killed, limits.Time, stderr.String())
+		fail(refs[done], status, message, time.Since(started))
+		return refs[done+1:]
+	case done > 0:
+		// The child stopped between cases, e.g. after a TLE
+		return refs[done:]
+	default:
+		status, message := r.crashVerdict(waitErr, killed, limits.Time, stderr.String())
+		if status == StatusMLE {
+			// The child hit the memory limit just before reporting the start
+			// of the first case
+			fail(refs[0], status, message, 0)
+			return refs[1:]
+		}
+		// The child failed before running any case, so a new child would fail too
+		failAll(message)
+		return nil
+	}
+}
+
+// crashVerdict explains why a child process exited while solving a case
+func (r *Runner) crashVerdict(waitErr error, killed bool, timeLimit time.Duration, stderr string) (Status, string) {
+	if killed {
+		return StatusTLE, fmt.Sprintf("killed after exceeding the time limit of %v", timeLimit)
+	}
+
+	if outOfMemory(stderr) {
+		if r.options.MemoryLimit > 0 {
+			return StatusMLE, fmt.Sprintf("out of memory (limit %v)", r.options.MemoryLimit)
+		}
+		return StatusRuntimeError, "out of memory"
+	}
+
+	// Prefer the reason the runtime or the child printed
+	for _, line := range strings.Split(stderr, "\n") {
+		for _, prefix := range []string{"fatal error: ", "panic: ", "sandbox: "} {
+			if strings.HasPrefix(line, prefix) {
+				return StatusRuntimeError, strings.TrimPrefix(line, "sandbox: ")
+			}
+		}
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(waitErr, &exitErr) {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return StatusRuntimeError, fmt.Sprintf("process killed by signal: %v", status.Signal())
+		}
+		return StatusRuntimeError, fmt.Sprintf("process exited with status %d", exitErr.ExitCode())
+	}
+	if waitErr != nil {
+		return StatusRuntimeError, waitErr.Error()
+	}
+	return StatusRuntimeError, "process exited with status 0"
+}
+
+// allocationFailures are the messages of the fatal errors the Go runtime
+// raises when the memory limit makes an allocation fail. Which one is printed
+// depends on where the allocation fails, so both mean the limit was hit.
+var allocationFailures = []string{
+	"out of memory",
+	"cannot allocate memory",
+}
+
+// outOfMemory reports whether a child died because an allocation failed
+func outOfMemory(stderr string) bool {
+	for _, message := range allocationFailures {
+		if strings.Contains(stderr, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// headBuffer keeps the first max bytes written to it and discards the rest
+type headBuffer struct {
+	buf bytes.Buffer
+	max int
+}
+
+// Write implements io.Writer
+func (b *headBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); room > 0 {
+		if len(p) > room {
+			b.buf.Write(p[:room])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// String returns the kept bytes
+func (b *headBuffer) String() string {
+	return b.buf.String()
+}
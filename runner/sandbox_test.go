@@ -0,0 +1,165 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"leetcodedaily/solver"
+)
+
+// TestMain serves the sandbox protocol when the test binary is started as the
+// child of an isolated run
+func TestMain(m *testing.M) {
+	if IsSandboxChild() {
+		os.Exit(RunSandboxChild(testRegistry()))
+	}
+	os.Exit(m.Run())
+}
+
+// hog allocates n blocks of 16MB and keeps them
+func hog(n int) int {
+	var blocks [][]byte
+	for i := 0; i < n; i++ {
+		block := make([]byte, 16<<20)
+		block[0] = 1
+		blocks = append(blocks, block)
+	}
+	return len(blocks)
+}
+
+// sleep returns n after sleeping for n milliseconds
+func sleep(n int) int {
+	time.Sleep(time.Duration(n) * time.Millisecond)
+	return n
+}
+
+// counted counts its calls in calls, then returns n after sleeping for n
+// milliseconds
+func counted(n int) int {
+	calls.Add(1)
+	return sleep(n)
+}
+
+var calls atomic.Int32
+
+// testBindings are the problems of the runner tests
+var testBindings = []solver.Binding{
+	{Problem: "hog", Func: hog, Params: []string{"n"}},
+	{Problem: "sleep", Func: sleep, Params: []string{"n"}},
+	{Problem: "counted", Func: counted, Params: []string{"n"}},
+}
+
+// testRegistry returns a registry with a solver for every test binding
+func testRegistry() *solver.Registry {
+	registry := solver.NewRegistry()
+	for _, b := range testBindings {
+		s, err := solver.NewReflectiveSolver(b, nil)
+		if err != nil {
+			panic(err)
+		}
+		registry.Register(b.Problem, s)
+	}
+	return registry
+}
+
+// writeTestFile writes a test file into a temporary directory and returns its
+// path
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// recorder is a Listener that keeps the results it receives
+type recorder struct {
+	results []Result
+	ended   []solver.ProblemType
+}
+
+func (r *recorder) StartProblem(problemType solver.ProblemType) {}
+
+func (r *recorder) CaseDone(result Result) {
+	r.results = append(r.results, result)
+}
+
+func (r *recorder) EndProblem(problemType solver.ProblemType) {
+	r.ended = append(r.ended, problemType)
+}
+
+func TestCrashVerdictOutOfMemory(t *testing.T) {
+	tests := []struct {
+		name        string
+		memoryLimit solver.ByteSize
+		stderr      string
+		status      Status
+		message     string
+	}{
+		{
+			name:        "out of memory",
+			memoryLimit: 64 * solver.Megabyte,
+			stderr:      "fatal error: runtime: out of memory\n\ngoroutine 1 [running]:\n",
+			status:      StatusMLE,
+			message:     "out of memory (limit 64MB)",
+		},
+		{
+			name:        "cannot allocate memory",
+			memoryLimit: 64 * solver.Megabyte,
+			stderr:      "fatal error: runtime: cannot allocate memory\n\ngoroutine 1 [running]:\n",
+			status:      StatusMLE,
+			message:     "out of memory (limit 64MB)",
+		},
+		{
+			name:    "no limit",
+			stderr:  "fatal error: runtime: cannot allocate memory\n",
+			status:  StatusRuntimeError,
+			message: "out of memory",
+		},
+		{
+			name:        "other fatal error",
+			memoryLimit: 64 * solver.Megabyte,
+			stderr:      "fatal error: all goroutines are asleep - deadlock!\n",
+			status:      StatusRuntimeError,
+			message:     "fatal error: all goroutines are asleep - deadlock!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(solver.NewRegistry(), Options{MemoryLimit: tt.memoryLimit})
+			status, message := r.crashVerdict(nil, false, 0, tt.stderr)
+			if status != tt.status || message != tt.message {
+				t.Errorf("crashVerdict() = %s, %q, want %s, %q", status, message, tt.status, tt.message)
+			}
+		})
+	}
+}
+
+func TestMemoryLimitVerdictIsStable(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the data segment limit is only enforced on Linux")
+	}
+	if testing.Short() {
+		t.Skip("starts child processes")
+	}
+
+	file := writeTestFile(t, "test1.txt", "Input: n = 64\nOutput: 64\n")
+	r := New(testRegistry(), Options{Isolation: IsolateCase, MemoryLimit: 64 * solver.Megabyte})
+	for i := 0; i < 5; i++ {
+		var rec recorder
+		r.Run([]Job{{Problem: "hog", Files: []string{file}}}, &rec)
+		if len(rec.results) != 1 {
+			t.Fatalf("run %d: got %d results, want 1", i, len(rec.results))
+		}
+		result := rec.results[0]
+		if result.Status != StatusMLE || result.Message != "out of memory (limit 64MB)" {
+			t.Errorf("run %d: got %s: %s, want MLE: out of memory (limit 64MB)", i, result.Status, result.Message)
+		}
+	}
+}
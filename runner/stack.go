@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// maxStackFrames is the number of frames shown for a panic outside of the
+// problem implementations
+const maxStackFrames = 5
+
+// solutionStack returns a trimmed stack trace of a recovered panic. It must
+// be called from the deferred function that recovered the panic. The trace
+// is limited to the frames in problems/<name>/*.go, so it points at the line
+// of the solution that failed.
+func solutionStack() []string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var solutionFrames, otherFrames []string
+	problemsDir := string(filepath.Separator) + "problems" + string(filepath.Separator)
+	for {
+		frame, more := frames.Next()
+
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			line := fmt.Sprintf("%s\n           %s:%d", frame.Function, relativePath(frame.File), frame.Line)
+			if strings.Contains(filepath.FromSlash(frame.File), problemsDir) {
+				solutionFrames = append(solutionFrames, line)
+			} else if len(otherFrames) < maxStackFrames {
+				otherFrames = append(otherFrames, line)
+			}
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if len(solutionFrames) > 0 {
+		return solutionFrames
+	}
+	return otherFrames
+}
+
+// relativePath returns path relative to the working directory when possible
+func relativePath(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, filepath.FromSlash(path))
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
@@ -0,0 +1,111 @@
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Binding describes how a problem type is wired to the solution function
+// implemented in problems/<name>
+type Binding struct {
+	// Problem is the problem type, which matches the directory name
+	Problem ProblemType
+	// Func is the exported solution function, e.g. two_sum.TwoSum
+	Func interface{}
+	// Params are the names of the function parameters in declaration order.
+	// They match the parameter names used in the LeetCode "Input:" line.
+	Params []string
+	// Compare is the comparison mode for the output, declared with a
+	// "//leetcode:compare <mode>" directive on the solution function
+	Compare CompareMode
+	// Checker judges outputs instead of Compare when set, declared with a
+	// "//leetcode:checker <FuncName>" directive on the solution function
+	Checker Checker
+	// InPlace names a slice argument that the solution modifies in place,
+	// declared with a "//leetcode:inplace <param>" directive. If the function
+	// returns nothing, the argument after the call is the output, as in Merge
+	// Sorted Array. If it returns k, only the first k elements of the argument
+	// are judged along with k, as in Remove Element.
+	InPlace string
+	// Encoding names the Encoding that builds the arguments from the test
+	// case inputs, declared with a "//leetcode:encoding <name>" directive
+	Encoding string
+	// Graph declares a parameter that takes an edge list as an adjacency
+	// structure, declared with a "//leetcode:graph <param> <nodes> [directed]"
+	// directive
+	Graph GraphParam
+	// Design marks a design problem such as LRU Cache, whose Func is the
+	// Constructor of a class. Test cases list the operations, and every
+	// operation after the first calls the method of the same name.
+	Design bool
+	// Judge sets up the API of an interactive problem for every test case,
+	// declared with a "//leetcode:judge <FuncName>" directive
+	Judge JudgeFunc
+	// Limits are the resource limits of the problem
+	Limits Limits
+	// Difficulty is the difficulty of the problem, declared with a
+	// "//leetcode:difficulty <level>" directive
+	Difficulty Difficulty
+	// Tags are the topic tags of the problem, such as "hash-table", declared
+	// with a "//leetcode:tags <tag>,..." directive
+	Tags []string
+}
+
+var (
+	bindingsMu sync.RWMutex
+	bindings   = make(map[ProblemType]Binding)
+)
+
+// Bind registers the binding for a problem. It is called from the generated
+// problems/bindings_gen.go file and panics on invalid or duplicate bindings,
+// since both indicate a stale or hand-edited generated file.
+func Bind(b Binding) {
+	if err := validateBinding(b); err != nil {
+		panic(fmt.Sprintf("solver: invalid binding for %s: %v", b.Problem, err))
+	}
+
+	bindingsMu.Lock()
+	defer bindingsMu.Unlock()
+
+	if _, exists := bindings[b.Problem]; exists {
+		panic(fmt.Sprintf("solver: duplicate binding for %s", b.Problem))
+	}
+	bindings[b.Problem] = b
+}
+
+// LookupBinding returns the binding registered for a problem type
+func LookupBinding(problemType ProblemType) (Binding, bool) {
+	bindingsMu.RLock()
+	defer bindingsMu.RUnlock()
+
+	b, exists := bindings[problemType]
+	return b, exists
+}
+
+// checker returns the checker of a binding: its own, or the checker of its
+// encoding, if any
+func (b Binding) checker() Checker {
+	if b.Checker != nil {
+		return b.Checker
+	}
+	if encoding, ok := LookupEncoding(b.Encoding); ok {
+		if checked, ok := encoding.(CheckedEncoding); ok {
+			return checked.Checker()
+		}
+	}
+	return nil
+}
+
+// BoundProblems returns the problem types that have a binding, in order
+func BoundProblems() []ProblemType {
+	bindingsMu.RLock()
+	defer bindingsMu.RUnlock()
+
+	problems := make([]ProblemType, 0, len(bindings))
+	for problemType := range bindings {
+		problems = append(problems, problemType)
+	}
+	sort.Slice(problems, func(i, j int) bool { return problems[i] < problems[j] })
+	return problems
+}
@@ -0,0 +1,70 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Verdict is the outcome of checking the output of a solution
+type Verdict struct {
+	// OK reports whether the output was accepted
+	OK bool
+	// Message explains the verdict, e.g. why an output was rejected
+	Message string
+}
+
+// Accept returns an accepting verdict
+func Accept() Verdict {
+	return Verdict{OK: true}
+}
+
+// Reject returns a rejecting verdict with a formatted message
+func Reject(format string, args ...interface{}) Verdict {
+	return Verdict{Message: fmt.Sprintf(format, args...)}
+}
+
+// Checker judges the output of a solution. It is used for problems that
+// accept more than one answer, such as "return any topological order".
+type Checker interface {
+	// Check receives the input parameters of the test case, the expected
+	// output from the test file and the actual output of the solution
+	Check(input map[string]interface{}, expected, actual interface{}) Verdict
+}
+
+// CheckerFunc adapts an ordinary function to the Checker interface
+type CheckerFunc func(input map[string]interface{}, expected, actual interface{}) Verdict
+
+// Check implements the Checker interface
+func (f CheckerFunc) Check(input map[string]interface{}, expected, actual interface{}) Verdict {
+	return f(input, expected, actual)
+}
+
+// CompareChecker accepts outputs that match the expected output under a
+// comparison mode
+type CompareChecker struct {
+	Mode CompareMode
+}
+
+// Check implements the Checker interface
+func (c CompareChecker) Check(input map[string]interface{}, expected, actual interface{}) Verdict {
+	if c.Mode.Equal(expected, actual) {
+		return Accept()
+	}
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return Reject("expected a %T, got a %T", expected, actual)
+	}
+	return Reject("output does not match the expected output")
+}
+
+// comparatorChecker accepts outputs that a solver's Comparator accepts
+type comparatorChecker struct {
+	comparator Comparator
+}
+
+// Check implements the Checker interface
+func (c comparatorChecker) Check(input map[string]interface{}, expected, actual interface{}) Verdict {
+	if c.comparator.CompareOutput(expected, actual) {
+		return Accept()
+	}
+	return Reject("output does not match the expected output")
+}
@@ -0,0 +1,80 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Codec converts between a type and the parsed values that represent it in
+// test cases, such as binary trees given in LeetCode's level-order format
+// [3,9,20,null,null,15,7]. Codecs let solutions take and return linked
+// structures while test cases keep using plain literals.
+type Codec interface {
+	// Decode converts a parsed test case value into a value of the type
+	Decode(value interface{}) (interface{}, error)
+	// Encode converts a value of the type back into a parsed value, which is
+	// used to copy, compare and diff values of the type
+	Encode(value interface{}) (interface{}, error)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = make(map[reflect.Type]Codec)
+)
+
+// RegisterCodec registers the codec for a type, usually from the init
+// function of the package that declares the type. It panics if the type
+// already has a codec.
+func RegisterCodec(t reflect.Type, codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+
+	if _, exists := codecs[t]; exists {
+		panic(fmt.Sprintf("solver: duplicate codec for %s", t))
+	}
+	codecs[t] = codec
+}
+
+// lookupCodec returns the codec registered for a type
+func lookupCodec(t reflect.Type) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+
+	codec, exists := codecs[t]
+	return codec, exists
+}
+
+// decodeValue converts a parsed value into the codec's type
+func decodeValue(codec Codec, value interface{}, t reflect.Type) (reflect.Value, error) {
+	decoded, err := codec.Decode(value)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if decoded == nil {
+		return reflect.Zero(t), nil
+	}
+
+	v := reflect.ValueOf(decoded)
+	if v.Type() != t {
+		return reflect.Value{}, fmt.Errorf("codec for %s decoded a %s", t, v.Type())
+	}
+	return v, nil
+}
+
+// encodedValue returns the parsed form of a value whose type has a codec,
+// and reports false for other values or values that can't be encoded
+func encodedValue(v reflect.Value) (interface{}, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	codec, ok := lookupCodec(v.Type())
+	if !ok {
+		return nil, false
+	}
+	encoded, err := codec.Encode(v.Interface())
+	if err != nil {
+		return nil, false
+	}
+	return encoded, true
+}
@@ -0,0 +1,121 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CompareMode selects how the output of a solution is compared with the
+// expected output
+type CompareMode string
+
+const (
+	// CompareExact requires the output to be deeply equal to the expected output
+	CompareExact CompareMode = "exact"
+	// CompareUnordered accepts a list in any order, e.g. [1,0] for [0,1].
+	// Lists are compared as multisets, so duplicates must match.
+	CompareUnordered CompareMode = "unordered"
+	// CompareUnorderedNested accepts a list of lists where both the outer
+	// list and every inner list may be in any order, e.g. for 3Sum or
+	// Group Anagrams
+	CompareUnorderedNested CompareMode = "unordered-nested"
+	// CompareSet accepts a list in any order, ignoring duplicates
+	CompareSet CompareMode = "set"
+)
+
+// Comparator is implemented by solvers that don't compare outputs with
+// exact equality
+type Comparator interface {
+	// CompareOutput reports whether the actual output matches the expected output
+	CompareOutput(expected, actual interface{}) bool
+}
+
+// ParseCompareMode parses the name of a comparison mode. An empty name is
+// the same as "exact".
+func ParseCompareMode(name string) (CompareMode, error) {
+	switch mode := CompareMode(name); mode {
+	case "":
+		return CompareExact, nil
+	case CompareExact, CompareUnordered, CompareUnorderedNested, CompareSet:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown comparison mode %q (expected exact, unordered, unordered-nested or set)", name)
+	}
+}
+
+// Equal reports whether actual matches expected under the comparison mode.
+// Values that are not lists are always compared exactly.
+func (m CompareMode) Equal(expected, actual interface{}) bool {
+	if m == "" || m == CompareExact {
+		return reflect.DeepEqual(expected, actual)
+	}
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return false
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if expectedValue.Kind() != reflect.Slice && expectedValue.Kind() != reflect.Array {
+		return reflect.DeepEqual(expected, actual)
+	}
+
+	nested := m == CompareUnorderedNested
+	expectedKeys := elementKeys(expectedValue, nested)
+	actualKeys := elementKeys(actualValue, nested)
+
+	if m == CompareSet {
+		expectedKeys = dedupe(expectedKeys)
+		actualKeys = dedupe(actualKeys)
+	}
+
+	if len(expectedKeys) != len(actualKeys) {
+		return false
+	}
+	for i := range expectedKeys {
+		if expectedKeys[i] != actualKeys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// elementKeys returns a sorted canonical key for every element of a list.
+// With nested set, the elements of inner lists are sorted as well, so inner
+// lists in a different order produce the same key.
+func elementKeys(list reflect.Value, nested bool) []string {
+	keys := make([]string, list.Len())
+	for i := range keys {
+		elem := list.Index(i)
+		if nested && (elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array) {
+			keys[i] = "[" + strings.Join(elementKeys(elem, false), ",") + "]"
+		} else {
+			keys[i] = elementKey(elem)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// elementKey returns the canonical key of a list element. Values with a codec,
+// such as trees, are keyed by their encoded form rather than by pointers.
+func elementKey(elem reflect.Value) string {
+	if encoded, ok := encodedValue(elem); ok {
+		return fmt.Sprintf("%#v", encoded)
+	}
+	return fmt.Sprintf("%#v", elem.Interface())
+}
+
+// dedupe removes adjacent duplicates from sorted keys
+func dedupe(keys []string) []string {
+	result := keys[:0]
+	for i, key := range keys {
+		if i == 0 || key != keys[i-1] {
+			result = append(result, key)
+		}
+	}
+	return result
+}
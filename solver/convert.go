@@ -0,0 +1,184 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// convertValue converts a parsed test case value into a value of type t so it
+// can be passed to a solution function
+func convertValue(value interface{}, t reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("cannot use null as %s", t)
+	}
+
+	v := reflect.ValueOf(value)
+
+	// Values that already have the right type are passed through untouched
+	if v.Type() == t {
+		return v, nil
+	}
+	if t.Kind() == reflect.Interface && v.Type().Implements(t) {
+		return v.Convert(t), nil
+	}
+
+	// Types such as trees and linked lists are decoded by their codec
+	if codec, ok := lookupCodec(t); ok {
+		return decodeValue(codec, value, t)
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return reflect.Value{}, fmt.Errorf("cannot use %v (%T) as %s", value, value, t)
+		}
+
+		result := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := convertValue(v.Index(i).Interface(), t.Elem())
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("index %d: %w", i, err)
+			}
+			result.Index(i).Set(elem)
+		}
+		return result, nil
+	}
+
+	switch {
+	case t.Kind() == reflect.String:
+		// Chars such as 'a' can be used where a string is expected
+		if r, ok := value.(rune); ok {
+			return reflect.ValueOf(string(r)).Convert(t), nil
+		}
+	case t.Kind() == reflect.Uint8 || t.Kind() == reflect.Int32:
+		// Single character strings such as "1" in [["1","0"]] can be used
+		// where a byte or rune is expected
+		if str, ok := value.(string); ok {
+			if r := []rune(str); len(r) == 1 && (t.Kind() == reflect.Int32 || r[0] < 0x80) {
+				return reflect.ValueOf(r[0]).Convert(t), nil
+			}
+		}
+	}
+
+	if isNumber(v.Kind()) && isNumber(t.Kind()) {
+		return convertNumber(v, t)
+	}
+	if v.Type().ConvertibleTo(t) && v.Kind() == t.Kind() {
+		return v.Convert(t), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %v (%T) as %s", value, value, t)
+}
+
+// convertNumber converts between numeric kinds, rejecting values that would
+// overflow the target type or lose their fractional part
+func convertNumber(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	switch {
+	case v.CanInt():
+		n := v.Int()
+		switch {
+		case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		case isUnsigned(t.Kind()):
+			if n < 0 || reflect.Zero(t).OverflowUint(uint64(n)) {
+				return reflect.Value{}, fmt.Errorf("%d overflows %s", n, t)
+			}
+		default:
+			if reflect.Zero(t).OverflowInt(n) {
+				return reflect.Value{}, fmt.Errorf("%d overflows %s", n, t)
+			}
+		}
+	case v.CanUint():
+		n := v.Uint()
+		switch {
+		case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		case isUnsigned(t.Kind()):
+			if reflect.Zero(t).OverflowUint(n) {
+				return reflect.Value{}, fmt.Errorf("%d overflows %s", n, t)
+			}
+		default:
+			if n > math.MaxInt64 || reflect.Zero(t).OverflowInt(int64(n)) {
+				return reflect.Value{}, fmt.Errorf("%d overflows %s", n, t)
+			}
+		}
+	case v.CanFloat():
+		f := v.Float()
+		if t.Kind() != reflect.Float32 && t.Kind() != reflect.Float64 {
+			return reflect.Value{}, fmt.Errorf("cannot use %v as %s", f, t)
+		}
+		if reflect.Zero(t).OverflowFloat(f) {
+			return reflect.Value{}, fmt.Errorf("%v overflows %s", f, t)
+		}
+	}
+
+	return v.Convert(t), nil
+}
+
+// isUnsigned reports whether a kind is an unsigned integer kind
+func isUnsigned(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isNumber reports whether a kind is an integer or floating point kind
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// cloneValue returns a deep copy of slices, arrays, maps and values with a
+// codec, so a solution can modify its arguments without changing the parsed
+// test case
+func cloneValue(v reflect.Value) reflect.Value {
+	if codec, ok := lookupCodec(v.Type()); ok {
+		// Values with a codec are copied by encoding and decoding them
+		if encoded, err := codec.Encode(v.Interface()); err == nil {
+			if clone, err := decodeValue(codec, encoded, v.Type()); err == nil {
+				return clone
+			}
+		}
+		return v
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return clone
+	case reflect.Array:
+		clone := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			clone.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return clone
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		clone := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return clone
+	default:
+		return v
+	}
+}
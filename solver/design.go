@@ -0,0 +1,339 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Input names of design problems, whose examples give the operations and
+// their arguments as two lists:
+//
+//	Input
+//	["LRUCache", "put", "get"]
+//	[[2], [1, 1], [1]]
+const (
+	operationsInput = "operations"
+	argumentsInput  = "arguments"
+)
+
+// DesignResult is the output of design problems such as LRU Cache, which
+// construct an object and call a sequence of its methods. It holds the value
+// returned by every operation, with nil for the constructor and methods that
+// return nothing.
+type DesignResult struct {
+	// Operations are the names of the operations, starting with the class
+	Operations []string
+	// Outputs are the values returned by the operations
+	Outputs []interface{}
+}
+
+// String formats the outputs the way LeetCode prints them, with null for
+// operations that return nothing, e.g. "[null null 1]"
+func (r DesignResult) String() string {
+	parts := make([]string, len(r.Outputs))
+	for i, output := range r.Outputs {
+		parts[i] = formatOutput(output)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// formatOutput formats the output of one operation
+func formatOutput(output interface{}) string {
+	if output == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%v", output)
+}
+
+// methodName returns the Go method for a LeetCode operation name, e.g. "Get"
+// for "get"
+func methodName(operation string) string {
+	r, size := utf8.DecodeRuneInString(operation)
+	return string(unicode.ToUpper(r)) + operation[size:]
+}
+
+// designClass returns the type constructed by a design problem's
+// constructor, which is its only result, or a pointer to it
+func designClass(fnType reflect.Type) (reflect.Type, error) {
+	if fnType.NumOut() != 1 {
+		return nil, fmt.Errorf("the constructor must return the object, got %s", fnType)
+	}
+	class := fnType.Out(0)
+	if class.Kind() == reflect.Ptr {
+		class = class.Elem()
+	}
+	if class.Name() == "" {
+		return nil, fmt.Errorf("the constructor must return a named type, got %s", fnType.Out(0))
+	}
+	return class, nil
+}
+
+// operationMethod returns the method called by an operation on an object of
+// the constructed class
+func operationMethod(class reflect.Type, operation string) (reflect.Method, error) {
+	method, ok := reflect.PointerTo(class).MethodByName(methodName(operation))
+	if !ok {
+		return reflect.Method{}, fmt.Errorf("%s has no method %s for operation %q", class.Name(), methodName(operation), operation)
+	}
+	if method.Type.NumOut() > 1 {
+		return reflect.Method{}, fmt.Errorf("method %s returns more than one value", method.Name)
+	}
+	return method, nil
+}
+
+// designOperations converts the parsed operations and arguments of a design
+// problem and checks them against the class
+func (s *ReflectiveSolver) designOperations(params map[string]interface{}) ([]string, [][]reflect.Value, error) {
+	operations, err := convertValue(params[operationsInput], reflect.TypeOf([]string(nil)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("operations: %w", err)
+	}
+	names := operations.Interface().([]string)
+
+	lists, ok := params[argumentsInput]
+	argsValue := reflect.ValueOf(lists)
+	if !ok || argsValue.Kind() != reflect.Slice {
+		return nil, nil, fmt.Errorf("arguments: expected a list of argument lists, got %v", lists)
+	}
+	if argsValue.Len() != len(names) {
+		return nil, nil, fmt.Errorf("%d operations but %d argument lists", len(names), argsValue.Len())
+	}
+
+	fnType := s.fn.Type()
+	class, _ := designClass(fnType)
+	if len(names) == 0 || names[0] != class.Name() {
+		return nil, nil, fmt.Errorf("the first operation must construct %s", class.Name())
+	}
+
+	args := make([][]reflect.Value, len(names))
+	for i, name := range names {
+		// The constructor is a function, methods take the receiver first
+		callType, first := fnType, 0
+		if i > 0 {
+			method, err := operationMethod(class, name)
+			if err != nil {
+				return nil, nil, fmt.Errorf("operation %d: %w", i, err)
+			}
+			callType, first = method.Type, 1
+		}
+
+		values, err := argumentValues(argsValue.Index(i).Interface())
+		if err != nil {
+			return nil, nil, fmt.Errorf("operation %d (%s): %w", i, name, err)
+		}
+		if len(values) != callType.NumIn()-first {
+			return nil, nil, fmt.Errorf("operation %d (%s): expected %d arguments, got %d", i, name, callType.NumIn()-first, len(values))
+		}
+		for j, value := range values {
+			arg, err := convertValue(value, callType.In(first+j))
+			if err != nil {
+				return nil, nil, fmt.Errorf("operation %d (%s): argument %d: %w", i, name, j, err)
+			}
+			// Work on a copy so the methods can keep and modify their
+			// arguments without changing the test case
+			args[i] = append(args[i], cloneValue(arg))
+		}
+	}
+	return names, args, nil
+}
+
+// argumentValues returns the elements of a parsed list
+func argumentValues(value interface{}) ([]interface{}, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a list of arguments, got %v", value)
+	}
+	values := make([]interface{}, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+	return values, nil
+}
+
+// replay constructs the object of a design problem and calls its methods in
+// the order of the operations
+func (s *ReflectiveSolver) replay(params map[string]interface{}) (interface{}, error) {
+	names, args, err := s.designOperations(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := DesignResult{Operations: names, Outputs: make([]interface{}, len(names))}
+	i := 0
+	// Panics keep the stack of the solution, and are wrapped to name the
+	// operation that raised them
+	defer func() {
+		if r := recover(); r != nil {
+			panic(&OperationPanic{Index: i, Operation: names[i], Value: r})
+		}
+	}()
+
+	// Methods with pointer receivers need an addressable object
+	object := s.fn.Call(args[0])[0]
+	if object.Kind() != reflect.Ptr {
+		ptr := reflect.New(object.Type())
+		ptr.Elem().Set(object)
+		object = ptr
+	}
+
+	for i = 1; i < len(names); i++ {
+		out := object.MethodByName(methodName(names[i])).Call(args[i])
+		if len(out) == 1 {
+			result.Outputs[i] = out[0].Interface()
+		}
+	}
+	return result, nil
+}
+
+// OperationPanic is the value a design problem panics with when one of its
+// operations panics. Value is the value of the original panic.
+type OperationPanic struct {
+	// Index is the index of the operation, 0 for the constructor
+	Index     int
+	Operation string
+	Value     interface{}
+}
+
+func (p *OperationPanic) Error() string {
+	return fmt.Sprintf("operation %d (%s): %v", p.Index, p.Operation, p.Value)
+}
+
+// Unwrap returns the original panic value if it is an error, such as a
+// runtime.Error
+func (p *OperationPanic) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// parseDesignExample parses an example of a design problem. The expected
+// output holds the value of every operation, converted to the result type of
+// its method.
+func (s *ReflectiveSolver) parseDesignExample(filePath string, example Example) (TestCase, error) {
+	testCase := TestCase{
+		FilePath:       filePath,
+		ProblemType:    s.problemType,
+		InputParams:    make(map[string]interface{}),
+		ExpectedParams: make(map[string]interface{}),
+		InputNames:     []string{operationsInput, argumentsInput},
+	}
+	inputSection, outputSection := example.Input, example.Output
+
+	inputs, offsets, err := ParseValues(inputSection.Text)
+	if err != nil {
+		return testCase, inputSection.wrapError(filePath, "invalid input", err)
+	}
+	if len(inputs) != 2 {
+		return testCase, inputSection.Errorf(filePath, 0,
+			"invalid input: expected a list of operations and a list of their arguments, found %d values", len(inputs))
+	}
+	testCase.InputParams[operationsInput] = inputs[0]
+	testCase.InputParams[argumentsInput] = inputs[1]
+
+	names, _, err := s.designOperations(testCase.InputParams)
+	if err != nil {
+		return testCase, inputSection.Errorf(filePath, offsets[0], "invalid input: %v", err)
+	}
+
+	outputs, outputOffsets, err := ParseValues(outputSection.Text)
+	if err != nil {
+		return testCase, outputSection.wrapError(filePath, "invalid output", err)
+	}
+	if len(outputs) != 1 {
+		return testCase, outputSection.Errorf(filePath, 0, "invalid output: expected the list of operation outputs")
+	}
+	values, err := argumentValues(outputs[0])
+	if err != nil || len(values) != len(names) {
+		return testCase, outputSection.Errorf(filePath, outputOffsets[0],
+			"invalid output: expected a list of %d operation outputs, got %v", len(names), outputs[0])
+	}
+
+	expected := DesignResult{Operations: names, Outputs: make([]interface{}, len(names))}
+	class, _ := designClass(s.fn.Type())
+	for i, value := range values {
+		var resultType reflect.Type
+		if i > 0 {
+			method, _ := operationMethod(class, names[i])
+			if method.Type.NumOut() == 1 {
+				resultType = method.Type.Out(0)
+			}
+		}
+
+		if resultType == nil {
+			if value != nil {
+				return testCase, outputSection.Errorf(filePath, outputOffsets[0],
+					"invalid output: operation %d (%s) returns nothing, expected null, got %v", i, names[i], value)
+			}
+			continue
+		}
+		converted, err := convertValue(value, resultType)
+		if err != nil {
+			return testCase, outputSection.Errorf(filePath, outputOffsets[0], "invalid output: operation %d (%s): %v", i, names[i], err)
+		}
+		expected.Outputs[i] = converted.Interface()
+	}
+	testCase.ExpectedOutput = expected
+
+	return testCase, nil
+}
+
+// equalDesignResults compares the outputs of two design results, using the
+// comparison mode for every output
+func equalDesignResults(mode CompareMode, expected, actual DesignResult) bool {
+	if len(expected.Outputs) != len(actual.Outputs) {
+		return false
+	}
+	for i := range expected.Outputs {
+		if !mode.Equal(expected.Outputs[i], actual.Outputs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffDesignResults returns the difference in the output of the first
+// operation whose outputs differ, with the spans shifted to the formatted
+// results
+func diffDesignResults(mode CompareMode, expected, actual DesignResult) *Diff {
+	for i := range expected.Outputs {
+		if i >= len(actual.Outputs) {
+			break
+		}
+		e, a := expected.Outputs[i], actual.Outputs[i]
+		if mode.Equal(e, a) {
+			continue
+		}
+
+		d := mode.Diff(e, a)
+		if d == nil || e == nil || a == nil {
+			d = &Diff{Expected: formatOutput(e), Actual: formatOutput(a)}
+			d.ExpectedSpan = &[2]int{0, len(d.Expected)}
+			d.ActualSpan = &[2]int{0, len(d.Actual)}
+		}
+		d.Operation = expected.Operations[i]
+		d.Path = append([]int{i}, d.Path...)
+		if d.Length != nil {
+			d.Length.Path = append([]int{i}, d.Length.Path...)
+		}
+		if d.ExpectedSpan != nil {
+			d.ExpectedSpan = shiftSpan(d.ExpectedSpan, outputOffset(expected, i))
+		}
+		if d.ActualSpan != nil {
+			d.ActualSpan = shiftSpan(d.ActualSpan, outputOffset(actual, i))
+		}
+		return d
+	}
+	return nil
+}
+
+// outputOffset returns the byte offset of the i-th output in a formatted
+// design result
+func outputOffset(r DesignResult, i int) int {
+	offset := len("[")
+	for _, output := range r.Outputs[:i] {
+		offset += len(formatOutput(output)) + len(" ")
+	}
+	return offset
+}
@@ -0,0 +1,294 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Diff locates the first difference between an expected and an actual output
+type Diff struct {
+	// Param names the argument the path refers to for in-place problems,
+	// e.g. "nums" in "2, nums = [2 2]"
+	Param string `json:"param,omitempty"`
+	// Operation names the operation at the first index of the path for
+	// design problems, e.g. "get"
+	Operation string `json:"operation,omitempty"`
+	// Path holds the index of the first differing element at each level of
+	// nesting, e.g. [1 2] for row 1, column 2 of a matrix. It is empty if
+	// the values differ as a whole.
+	Path []int `json:"path,omitempty"`
+	// Expected and Actual are the differing elements. The element missing
+	// from the shorter of two lists is empty.
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	// Length describes the first list along the path whose length differs
+	Length *LengthDiff `json:"length,omitempty"`
+	// Sorted is set if the lists were sorted before they were compared,
+	// because the comparison mode ignores their order
+	Sorted bool `json:"sorted,omitempty"`
+	// ExpectedSpan and ActualSpan are the byte offsets [start, end) of the
+	// differing elements in the outputs formatted with %v. They are only set
+	// when the elements could be located.
+	ExpectedSpan *[2]int `json:"expected_span,omitempty"`
+	ActualSpan   *[2]int `json:"actual_span,omitempty"`
+}
+
+// LengthDiff is a length mismatch between an expected and an actual list
+type LengthDiff struct {
+	// Path is the path of the list, empty for the output itself
+	Path     []int `json:"path,omitempty"`
+	Expected int   `json:"expected"`
+	Actual   int   `json:"actual"`
+}
+
+// FormatPath formats an index path such as "[1][2]"
+func FormatPath(path []int) string {
+	var b strings.Builder
+	for _, i := range path {
+		fmt.Fprintf(&b, "[%d]", i)
+	}
+	return b.String()
+}
+
+// Differ is implemented by checkers and comparators that can locate the
+// difference between a rejected output and the expected output
+type Differ interface {
+	// Diff returns the first difference, or nil if none can be found
+	Diff(expected, actual interface{}) *Diff
+}
+
+// Diff implements the Differ interface
+func (c CompareChecker) Diff(expected, actual interface{}) *Diff {
+	return c.Mode.Diff(expected, actual)
+}
+
+// Diff implements the Differ interface
+func (c comparatorChecker) Diff(expected, actual interface{}) *Diff {
+	if differ, ok := c.comparator.(Differ); ok {
+		return differ.Diff(expected, actual)
+	}
+	return nil
+}
+
+// Diff returns the first difference between actual and expected under the
+// comparison mode, or nil if they match. Lists whose order doesn't matter
+// are sorted first, so the difference refers to the sorted lists.
+func (m CompareMode) Diff(expected, actual interface{}) *Diff {
+	if m.Equal(expected, actual) {
+		return nil
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	isList := expectedValue.Kind() == reflect.Slice || expectedValue.Kind() == reflect.Array
+	if m == "" || m == CompareExact || !isList || reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return valueDiff(expected, actual)
+	}
+
+	nested := m == CompareUnorderedNested
+	set := m == CompareSet
+	d := valueDiff(sortedList(expectedValue, nested, set), sortedList(reflect.ValueOf(actual), nested, set))
+	if d != nil {
+		d.Sorted = true
+		d.ExpectedSpan, d.ActualSpan = nil, nil
+	}
+	return d
+}
+
+// sortedList returns the elements of a list sorted by their canonical keys,
+// with the inner lists sorted as well if nested is set and duplicates
+// removed if set is set
+func sortedList(list reflect.Value, nested, set bool) []interface{} {
+	type keyed struct {
+		key   string
+		value interface{}
+	}
+
+	elems := make([]keyed, list.Len())
+	for i := range elems {
+		elem := list.Index(i)
+		if nested && (elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array) {
+			elems[i] = keyed{
+				key:   "[" + strings.Join(elementKeys(elem, false), ",") + "]",
+				value: sortedList(elem, false, false),
+			}
+		} else {
+			elems[i] = keyed{key: elementKey(elem), value: elem.Interface()}
+		}
+	}
+	sort.SliceStable(elems, func(i, j int) bool { return elems[i].key < elems[j].key })
+
+	values := make([]interface{}, 0, len(elems))
+	for i, elem := range elems {
+		if set && i > 0 && elem.key == elems[i-1].key {
+			continue
+		}
+		values = append(values, elem.value)
+	}
+	return values
+}
+
+// valueDiff returns the first difference between two values in depth-first
+// order, or nil if they are deeply equal
+func valueDiff(expected, actual interface{}) *Diff {
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+
+	d := &Diff{}
+	walkDiff(d, reflect.ValueOf(expected), reflect.ValueOf(actual), nil)
+
+	// Locate the differing elements in the formatted outputs. The spans are
+	// dropped if the rendering doesn't match %v, e.g. for Stringer elements.
+	if text, span, ok := render(expected, d.Path); ok && text == fmt.Sprintf("%v", expected) {
+		d.ExpectedSpan = span
+	}
+	if text, span, ok := render(actual, d.Path); ok && text == fmt.Sprintf("%v", actual) {
+		d.ActualSpan = span
+	}
+	return d
+}
+
+// walkDiff descends into the first differing elements of two lists and
+// records the path, the elements and the first length mismatch in d
+func walkDiff(d *Diff, expected, actual reflect.Value, path []int) {
+	expected, actual = unwrap(expected), unwrap(actual)
+
+	// Values with a codec, such as trees, are diffed in their encoded form,
+	// so the path is an index into e.g. the level-order list of a tree
+	if expected.IsValid() && actual.IsValid() && expected.Type() == actual.Type() {
+		expectedEncoded, ok1 := encodedValue(expected)
+		actualEncoded, ok2 := encodedValue(actual)
+		if ok1 && ok2 {
+			walkDiff(d, reflect.ValueOf(expectedEncoded), reflect.ValueOf(actualEncoded), path)
+			return
+		}
+	}
+
+	if !isList(expected) || !isList(actual) || expected.Type() != actual.Type() || isStringer(expected) {
+		d.Path = path
+		d.Expected = formatElem(expected)
+		d.Actual = formatElem(actual)
+		if expected.IsValid() && actual.IsValid() && expected.Type() != actual.Type() {
+			d.Expected += fmt.Sprintf(" (%s)", expected.Type())
+			d.Actual += fmt.Sprintf(" (%s)", actual.Type())
+		}
+		return
+	}
+
+	if expected.Len() != actual.Len() && d.Length == nil {
+		d.Length = &LengthDiff{Path: path, Expected: expected.Len(), Actual: actual.Len()}
+	}
+
+	n := expected.Len()
+	if actual.Len() < n {
+		n = actual.Len()
+	}
+	for i := 0; i < n; i++ {
+		if !reflect.DeepEqual(expected.Index(i).Interface(), actual.Index(i).Interface()) {
+			walkDiff(d, expected.Index(i), actual.Index(i), appendPath(path, i))
+			return
+		}
+	}
+
+	// The common elements are equal, so the first difference is the element
+	// missing from the shorter list
+	d.Path = appendPath(path, n)
+	if n < expected.Len() {
+		d.Expected = formatElem(expected.Index(n))
+	}
+	if n < actual.Len() {
+		d.Actual = formatElem(actual.Index(n))
+	}
+}
+
+// appendPath returns a copy of path with i appended
+func appendPath(path []int, i int) []int {
+	return append(append([]int(nil), path...), i)
+}
+
+// unwrap returns the value stored in an interface
+func unwrap(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// isList reports whether a value is a slice or an array
+func isList(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+}
+
+// isStringer reports whether a value formats itself, in which case it is
+// compared as a whole
+func isStringer(v reflect.Value) bool {
+	if !v.IsValid() || !v.CanInterface() {
+		return false
+	}
+	switch v.Interface().(type) {
+	case fmt.Stringer, error:
+		return true
+	}
+	return false
+}
+
+// formatElem formats an element the way %v formats it inside a list
+func formatElem(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	text, _, _ := render(v.Interface(), nil)
+	return text
+}
+
+// render formats a value like %v does for lists and returns the span of the
+// element at path. It reports false if the path doesn't lead to an element.
+func render(value interface{}, path []int) (string, *[2]int, bool) {
+	var b strings.Builder
+	span, ok := renderValue(&b, reflect.ValueOf(value), path, true)
+	return b.String(), span, ok
+}
+
+// renderValue writes a value to b and returns the span of the element at
+// path, if onPath is set and the element exists
+func renderValue(b *strings.Builder, v reflect.Value, path []int, onPath bool) (*[2]int, bool) {
+	v = unwrap(v)
+	start := b.Len()
+
+	if !isList(v) || isStringer(v) {
+		if v.IsValid() {
+			fmt.Fprintf(b, "%v", v.Interface())
+		} else {
+			b.WriteString("<nil>")
+		}
+		if onPath && len(path) == 0 {
+			return &[2]int{start, b.Len()}, true
+		}
+		return nil, false
+	}
+
+	var span *[2]int
+	found := false
+	b.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		elemOnPath := onPath && len(path) > 0 && path[0] == i
+		var rest []int
+		if elemOnPath {
+			rest = path[1:]
+		}
+		if s, ok := renderValue(b, v.Index(i), rest, elemOnPath); ok {
+			span, found = s, true
+		}
+	}
+	b.WriteByte(']')
+
+	if onPath && len(path) == 0 {
+		return &[2]int{start, b.Len()}, true
+	}
+	return span, found
+}
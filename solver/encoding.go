@@ -0,0 +1,80 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Encoding builds the arguments of problems whose test case inputs describe
+// several arguments at once, such as a linked list with a cycle given as
+// head = [3,2,0,-4], pos = 1, or two lists that share their tail. The
+// arguments are built afresh for every call, because copying them would
+// separate the nodes they share.
+//
+// Problems select an encoding with a "//leetcode:encoding <name>" directive.
+type Encoding interface {
+	// Inputs returns the names of the test case inputs the encoding reads
+	Inputs() []string
+	// Check reports whether the encoding supports a solution function type
+	Check(fnType reflect.Type) error
+	// Args builds the arguments of a call from the parsed inputs
+	Args(fnType reflect.Type, input map[string]interface{}) ([]reflect.Value, error)
+	// OutputType returns the type the results of type t are judged as, e.g.
+	// int for the index of a returned node. It returns t for results that are
+	// judged as they are.
+	OutputType(t reflect.Type) reflect.Type
+	// Output converts a result of a call with args into a value of the type
+	// returned by OutputType, or into a value that the encoding's checker
+	// judges against it
+	Output(result reflect.Value, args []reflect.Value) (interface{}, error)
+}
+
+// CheckedEncoding is implemented by encodings whose outputs are judged by a
+// checker of their own, e.g. one that checks that a copied graph shares no
+// node with the original. Problems that declare a checker use theirs instead.
+type CheckedEncoding interface {
+	Encoding
+	// Checker returns the checker of the outputs
+	Checker() Checker
+}
+
+var (
+	encodingsMu sync.RWMutex
+	encodings   = make(map[string]Encoding)
+)
+
+// RegisterEncoding registers an encoding under the name used by the
+// "//leetcode:encoding" directive. It panics if the name is taken.
+func RegisterEncoding(name string, encoding Encoding) {
+	encodingsMu.Lock()
+	defer encodingsMu.Unlock()
+
+	if _, exists := encodings[name]; exists {
+		panic(fmt.Sprintf("solver: duplicate encoding %q", name))
+	}
+	encodings[name] = encoding
+}
+
+// LookupEncoding returns the encoding registered under a name
+func LookupEncoding(name string) (Encoding, bool) {
+	encodingsMu.RLock()
+	defer encodingsMu.RUnlock()
+
+	encoding, exists := encodings[name]
+	return encoding, exists
+}
+
+// EncodingNames returns the names of the registered encodings, sorted
+func EncodingNames() []string {
+	encodingsMu.RLock()
+	defer encodingsMu.RUnlock()
+
+	names := make([]string, 0, len(encodings))
+	for name := range encodings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
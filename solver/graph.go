@@ -0,0 +1,162 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// GraphParam declares a parameter whose test case value is an edge list over
+// the nodes 0 to n-1, such as edges = [[0,1],[1,2]] with n = 3, and which the
+// solution takes as an adjacency structure. The type of the parameter selects
+// the structure: [][]int is an adjacency list, [][]bool an adjacency matrix,
+// and other types can be added with RegisterGraphType.
+type GraphParam struct {
+	// Param is the name of the edge list parameter
+	Param string
+	// Nodes is the name of the parameter holding the number of nodes
+	Nodes string
+	// Directed makes every edge [u,v] go from u to v only, rather than both
+	// ways
+	Directed bool
+}
+
+// GraphBuilder builds an adjacency structure over n nodes from edges whose
+// endpoints have already been checked to be nodes
+type GraphBuilder func(n int, edges [][]int, directed bool) (interface{}, error)
+
+var (
+	graphTypesMu sync.RWMutex
+	graphTypes   = map[reflect.Type]GraphBuilder{
+		reflect.TypeOf([][]int(nil)): func(n int, edges [][]int, directed bool) (interface{}, error) {
+			return AdjacencyList(n, edges, directed), nil
+		},
+		reflect.TypeOf([][]bool(nil)): func(n int, edges [][]int, directed bool) (interface{}, error) {
+			return AdjacencyMatrix(n, edges, directed), nil
+		},
+	}
+)
+
+// RegisterGraphType registers the builder of an adjacency structure type for
+// graph parameters. It panics if the type already has a builder.
+func RegisterGraphType(t reflect.Type, build GraphBuilder) {
+	graphTypesMu.Lock()
+	defer graphTypesMu.Unlock()
+
+	if _, exists := graphTypes[t]; exists {
+		panic(fmt.Sprintf("solver: duplicate graph type %s", t))
+	}
+	graphTypes[t] = build
+}
+
+// lookupGraphType returns the builder registered for a type
+func lookupGraphType(t reflect.Type) (GraphBuilder, bool) {
+	graphTypesMu.RLock()
+	defer graphTypesMu.RUnlock()
+
+	build, exists := graphTypes[t]
+	return build, exists
+}
+
+// AdjacencyList returns the neighbors of each of the n nodes, in the order of
+// the edges. A self loop lists its node once.
+func AdjacencyList(n int, edges [][]int, directed bool) [][]int {
+	adj := make([][]int, n)
+	for i := range adj {
+		adj[i] = []int{}
+	}
+	for _, e := range edges {
+		u, v := e[0], e[1]
+		adj[u] = append(adj[u], v)
+		if !directed && u != v {
+			adj[v] = append(adj[v], u)
+		}
+	}
+	return adj
+}
+
+// AdjacencyMatrix returns the n by n matrix whose element [u][v] reports
+// whether there is an edge from u to v
+func AdjacencyMatrix(n int, edges [][]int, directed bool) [][]bool {
+	adj := make([][]bool, n)
+	for i := range adj {
+		adj[i] = make([]bool, n)
+	}
+	for _, e := range edges {
+		u, v := e[0], e[1]
+		adj[u][v] = true
+		if !directed {
+			adj[v][u] = true
+		}
+	}
+	return adj
+}
+
+// edgesType is the type edge lists are converted to
+var edgesType = reflect.TypeOf([][]int(nil))
+
+// edges converts a parsed edge list and checks that its endpoints are nodes
+func (g GraphParam) edges(value interface{}, n int) ([][]int, error) {
+	v, err := convertValue(value, edgesType)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := v.Interface().([][]int)
+	for i, e := range edges {
+		if len(e) != 2 {
+			return nil, fmt.Errorf("edge %d: expected [u,v], got %v", i, e)
+		}
+		for _, node := range e {
+			if node < 0 || node >= n {
+				return nil, fmt.Errorf("edge %d: node %d is out of range for %s = %d", i, node, g.Nodes, n)
+			}
+		}
+	}
+	return edges, nil
+}
+
+// build builds the adjacency structure of type t from the parsed inputs
+func (g GraphParam) build(params map[string]interface{}, t reflect.Type) (reflect.Value, error) {
+	nodes, err := convertValue(params[g.Nodes], reflect.TypeOf(0))
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("parameter %q: %w", g.Nodes, err)
+	}
+	n := int(nodes.Int())
+	if n < 0 {
+		return reflect.Value{}, fmt.Errorf("parameter %q: negative number of nodes %d", g.Nodes, n)
+	}
+
+	edges, err := g.edges(params[g.Param], n)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("parameter %q: %w", g.Param, err)
+	}
+
+	build, _ := lookupGraphType(t)
+	graph, err := build(n, edges, g.Directed)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("parameter %q: %w", g.Param, err)
+	}
+	return reflect.ValueOf(graph), nil
+}
+
+// validateGraphParam checks a graph parameter against the parameters of a
+// solution function
+func validateGraphParam(g GraphParam, params []string, fnType reflect.Type) error {
+	i := paramIndex(params, g.Param)
+	if i < 0 {
+		return fmt.Errorf("graph parameter %q is not a parameter", g.Param)
+	}
+	if _, ok := lookupGraphType(fnType.In(i)); !ok {
+		return fmt.Errorf("graph parameter %q has type %s, which is not a graph type", g.Param, fnType.In(i))
+	}
+
+	j := paramIndex(params, g.Nodes)
+	if j < 0 {
+		return fmt.Errorf("node count %q of graph parameter %q is not a parameter", g.Nodes, g.Param)
+	}
+	if kind := fnType.In(j).Kind(); kind < reflect.Int || kind > reflect.Int64 {
+		return fmt.Errorf("node count %q has type %s, expected an integer", g.Nodes, fnType.In(j))
+	}
+	return nil
+}
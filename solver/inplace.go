@@ -0,0 +1,112 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// PrefixResult is the output of problems that modify an array argument in
+// place and return the number k of elements that remain at its front, such as
+// Remove Element or Remove Duplicates. Only the first k elements are judged.
+type PrefixResult struct {
+	// K is the returned number of elements
+	K int
+	// Param is the name of the modified argument
+	Param string
+	// Prefix holds the first K elements of the argument after the call. It is
+	// nil in expected outputs that only give k.
+	Prefix interface{}
+}
+
+// String formats the result the way LeetCode prints it, e.g. "2, nums = [2 2]"
+func (r PrefixResult) String() string {
+	if r.Prefix == nil {
+		return fmt.Sprintf("%d", r.K)
+	}
+	return fmt.Sprintf("%d, %s = %v", r.K, r.Param, r.Prefix)
+}
+
+// prefixResult builds the PrefixResult of a call that returned k for the
+// modified argument arg
+func prefixResult(param string, arg reflect.Value, k reflect.Value) (PrefixResult, error) {
+	if !k.CanInt() {
+		return PrefixResult{}, fmt.Errorf("in-place problems must return an integer k, got %s", k.Type())
+	}
+
+	n := int(k.Int())
+	if n < 0 || n > arg.Len() {
+		return PrefixResult{}, fmt.Errorf("returned k = %d is out of range for %s of length %d", n, param, arg.Len())
+	}
+
+	// Copy the prefix so later changes to the argument don't affect the result
+	prefix := reflect.MakeSlice(arg.Type(), n, n)
+	reflect.Copy(prefix, arg.Slice(0, n))
+
+	return PrefixResult{K: n, Param: param, Prefix: prefix.Interface()}, nil
+}
+
+// equalPrefixResults compares two prefix results, using the comparison mode
+// for the elements. LeetCode's judge sorts the elements for problems such as
+// Remove Element, which corresponds to the unordered mode.
+func equalPrefixResults(mode CompareMode, expected, actual PrefixResult) bool {
+	if expected.K != actual.K {
+		return false
+	}
+	if expected.Prefix == nil {
+		return true
+	}
+	return mode.Equal(expected.Prefix, actual.Prefix)
+}
+
+// diffPrefixResults returns the first difference between two prefix results,
+// with the spans shifted to their formatted results
+func diffPrefixResults(mode CompareMode, expected, actual PrefixResult) *Diff {
+	if expected.K != actual.K {
+		expectedK, actualK := fmt.Sprintf("%d", expected.K), fmt.Sprintf("%d", actual.K)
+		return &Diff{
+			Param:        "k",
+			Expected:     expectedK,
+			Actual:       actualK,
+			ExpectedSpan: &[2]int{0, len(expectedK)},
+			ActualSpan:   &[2]int{0, len(actualK)},
+		}
+	}
+	if expected.Prefix == nil {
+		return nil
+	}
+
+	d := mode.Diff(expected.Prefix, actual.Prefix)
+	if d == nil {
+		return nil
+	}
+	d.Param = expected.Param
+	if d.ExpectedSpan != nil {
+		d.ExpectedSpan = shiftSpan(d.ExpectedSpan, len(expected.String())-len(fmt.Sprintf("%v", expected.Prefix)))
+	}
+	if d.ActualSpan != nil {
+		d.ActualSpan = shiftSpan(d.ActualSpan, len(actual.String())-len(fmt.Sprintf("%v", actual.Prefix)))
+	}
+	return d
+}
+
+// shiftSpan returns a span moved by offset bytes
+func shiftSpan(span *[2]int, offset int) *[2]int {
+	return &[2]int{span[0] + offset, span[1] + offset}
+}
+
+// truncateList returns the first k elements of a parsed list
+func truncateList(value interface{}, k int) (interface{}, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("expected a list, got %v", value)
+	}
+	if k < 0 || k > v.Len() {
+		return nil, fmt.Errorf("k = %d is out of range for a list of length %d", k, v.Len())
+	}
+
+	prefix := make([]interface{}, k)
+	for i := range prefix {
+		prefix[i] = v.Index(i).Interface()
+	}
+	return typedSlice(prefix), nil
+}
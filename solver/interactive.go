@@ -0,0 +1,131 @@
+package solver
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Judge is the judge side of an interactive problem, such as Guess Number
+// Higher or Lower, for one test case. The problem's judge function uses the
+// hidden inputs of the case to back the API the solution calls, and reports
+// every call so that solutions exceeding the call budget are stopped.
+type Judge struct {
+	// Input holds the inputs of the test case, including the hidden ones
+	// such as pick that are not parameters of the solution
+	Input map[string]interface{}
+
+	budget int
+	calls  int
+}
+
+// JudgeFunc sets up the API of an interactive problem for a test case,
+// usually by assigning the package-level function the solution calls, e.g.
+// guess. It is declared with a "//leetcode:judge <Func>" directive.
+type JudgeFunc func(j *Judge) error
+
+// Int returns an integer input of the test case
+func (j *Judge) Int(name string) (int, error) {
+	value, ok := j.Input[name]
+	if !ok {
+		return 0, fmt.Errorf("missing input %q", name)
+	}
+	v, err := convertValue(value, reflect.TypeOf(0))
+	if err != nil {
+		return 0, fmt.Errorf("input %q: %w", name, err)
+	}
+	return int(v.Int()), nil
+}
+
+// SetBudget sets the number of calls the solution may make, overriding the
+// budget declared by the problem. Zero means no budget.
+func (j *Judge) SetBudget(budget int) {
+	j.budget = budget
+}
+
+// Budget returns the number of calls the solution may make, or 0 if there is
+// no budget
+func (j *Judge) Budget() int {
+	return j.budget
+}
+
+// Calls returns the number of calls the solution has made
+func (j *Judge) Calls() int {
+	return j.calls
+}
+
+// Call records a call to the API named api. A call over the budget stops the
+// solution by panicking with a *BudgetError, which the solver turns into the
+// verdict of the case.
+func (j *Judge) Call(api string) {
+	j.calls++
+	if j.budget > 0 && j.calls > j.budget {
+		panic(&BudgetError{API: api, Budget: j.budget})
+	}
+}
+
+// BudgetError reports a solution that exceeded the call budget of an
+// interactive problem
+type BudgetError struct {
+	// API is the function whose call exceeded the budget
+	API    string
+	Budget int
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("exceeded the budget of %d API calls with a call to %s", e.Budget, e.API)
+}
+
+// JudgedOutput is the output of an interactive problem, together with the
+// number of API calls the solution made. The runner judges Output and reports
+// the calls.
+type JudgedOutput struct {
+	Output interface{}
+	Calls  int
+	// Budget is the call budget, or 0 if there is none
+	Budget int
+}
+
+// String formats the output
+func (o JudgedOutput) String() string {
+	return fmt.Sprintf("%v", o.Output)
+}
+
+// InteractiveProblem is implemented by solvers of interactive problems. Their
+// judge's API is a package variable shared by every call of the solution, so
+// the runner solves their cases in child processes, one case at a time, and
+// a solution abandoned after a TLE can't call the API of a later case.
+type InteractiveProblem interface {
+	// Interactive reports whether the problem calls a judge's API
+	Interactive() bool
+}
+
+// Interactive implements the InteractiveProblem interface
+func (s *ReflectiveSolver) Interactive() bool {
+	return s.judge != nil
+}
+
+// solveInteractive sets up the judge for a test case and solves it
+func (s *ReflectiveSolver) solveInteractive(params map[string]interface{}) (output interface{}, err error) {
+	j := &Judge{Input: params, budget: s.limits.Calls}
+	if err := s.judge(j); err != nil {
+		return nil, fmt.Errorf("judge: %w", err)
+	}
+
+	// A solution over budget is stopped by a panic in Judge.Call. Other
+	// panics are passed on.
+	defer func() {
+		if r := recover(); r != nil {
+			budgetErr, ok := r.(*BudgetError)
+			if !ok {
+				panic(r)
+			}
+			output, err = nil, budgetErr
+		}
+	}()
+
+	output, err = s.solve(params)
+	if err != nil {
+		return nil, err
+	}
+	return JudgedOutput{Output: output, Calls: j.calls, Budget: j.budget}, nil
+}
@@ -0,0 +1,108 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits are the resource limits of a problem. Zero values mean the runner's
+// defaults apply.
+type Limits struct {
+	// Time is the time limit for a single test case, declared with a
+	// "//leetcode:timeout <duration>" directive on the solution function
+	Time time.Duration
+	// Memory is the limit on the bytes a solution may allocate for a single
+	// test case, declared with a "//leetcode:memory <size>" directive
+	Memory ByteSize
+	// Calls is the number of calls a solution of an interactive problem may
+	// make to the judge's API for a single test case, declared with a
+	// "//leetcode:calls <n>" directive
+	Calls int
+}
+
+// LimitedProblem is implemented by solvers whose problem declares its own
+// resource limits
+type LimitedProblem interface {
+	// Limits returns the resource limits of the problem
+	Limits() Limits
+}
+
+// ByteSize is a number of bytes. It can be parsed from sizes such as "256MB"
+// and used as a flag value.
+type ByteSize int64
+
+// Byte size units, in powers of 1024
+const (
+	Byte     ByteSize = 1
+	Kilobyte          = 1024 * Byte
+	Megabyte          = 1024 * Kilobyte
+	Gigabyte          = 1024 * Megabyte
+)
+
+// ParseByteSize parses a size such as "512", "64KB", "256MB" or "1GB".
+// Units are case-insensitive powers of 1024.
+func ParseByteSize(s string) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	upper := strings.ToUpper(s)
+
+	unit := Byte
+	for _, u := range []struct {
+		suffix string
+		size   ByteSize
+	}{
+		{"GB", Gigabyte},
+		{"MB", Megabyte},
+		{"KB", Kilobyte},
+		{"B", Byte},
+	} {
+		if strings.HasSuffix(upper, u.suffix) {
+			unit = u.size
+			upper = strings.TrimSpace(strings.TrimSuffix(upper, u.suffix))
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(upper, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q (expected a size such as 256MB)", s)
+	}
+	if n > math.MaxInt64/int64(unit) {
+		return 0, fmt.Errorf("size %q is too large", s)
+	}
+	return ByteSize(n) * unit, nil
+}
+
+// String formats the size with the largest unit that fits, e.g. "256MB".
+// Sizes that aren't a whole number of that unit are rounded to one decimal,
+// e.g. "1.5KB".
+func (b ByteSize) String() string {
+	for _, u := range []struct {
+		suffix string
+		size   ByteSize
+	}{
+		{"GB", Gigabyte},
+		{"MB", Megabyte},
+		{"KB", Kilobyte},
+	} {
+		switch {
+		case b >= u.size && b%u.size == 0:
+			return fmt.Sprintf("%d%s", b/u.size, u.suffix)
+		case b >= u.size:
+			return fmt.Sprintf("%.1f%s", float64(b)/float64(u.size), u.suffix)
+		}
+	}
+	return fmt.Sprintf("%dB", int64(b))
+}
+
+// Set implements flag.Value
+func (b *ByteSize) Set(s string) error {
+	size, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = size
+	return nil
+}
@@ -0,0 +1,480 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Literal is the result of parsing a LeetCode example line such as
+// "nums = [2,7,11,15], target = 9" or "2, nums = [2,2]"
+type Literal struct {
+	// Values are the unnamed values in order of appearance
+	Values []interface{}
+	// Params are the values given as "name = value"
+	Params map[string]interface{}
+	// Names are the parameter names in order of appearance
+	Names []string
+	// Offsets are the byte offsets of the named values in the parsed text
+	Offsets map[string]int
+	// ValueOffsets are the byte offsets of the unnamed values
+	ValueOffsets []int
+}
+
+// SyntaxError is returned when a literal cannot be parsed
+type SyntaxError struct {
+	// Offset is the byte offset of the error in the parsed text
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("offset %d: %s", e.Offset, e.Msg)
+}
+
+// ParseLiteral parses a comma-separated list of values and "name = value"
+// pairs using the LeetCode example grammar.
+//
+// Supported values are integers, floats, true/false, null, "_" for an
+// unspecified element (returned as nil), double-quoted
+// strings, single-quoted chars and arbitrarily nested arrays. Arrays whose
+// elements share a type are returned as typed slices ([]int, [][]int,
+// []string, ...). Integers are returned as int, or as int64 when they don't
+// fit in an int, and integers outside the int64 range are a syntax error.
+// Empty arrays and arrays mixing types or containing null
+// are returned as []interface{}.
+func ParseLiteral(s string) (Literal, error) {
+	p := &literalParser{lex: newLexer(s)}
+	p.next()
+
+	lit := Literal{
+		Params:  make(map[string]interface{}),
+		Offsets: make(map[string]int),
+	}
+	if p.tok.kind == tokEOF {
+		return lit, nil
+	}
+
+	for {
+		if p.tok.kind == tokIdent && p.peek().kind == tokEquals {
+			name := p.tok.text
+			if _, exists := lit.Params[name]; exists {
+				return lit, p.errorf("duplicate parameter %q", name)
+			}
+			p.next()
+			p.next()
+
+			offset := p.tok.offset
+			value, err := p.parseValue()
+			if err != nil {
+				return lit, err
+			}
+			lit.Params[name] = value
+			lit.Names = append(lit.Names, name)
+			lit.Offsets[name] = offset
+		} else {
+			offset := p.tok.offset
+			value, err := p.parseValue()
+			if err != nil {
+				return lit, err
+			}
+			lit.Values = append(lit.Values, value)
+			lit.ValueOffsets = append(lit.ValueOffsets, offset)
+		}
+
+		switch p.tok.kind {
+		case tokEOF:
+			return lit, nil
+		case tokComma:
+			p.next()
+		default:
+			return lit, p.errorf("expected ',' or end of input, found %s", p.tok)
+		}
+	}
+}
+
+// ParseValue parses a single LeetCode literal such as "[[1,2],[3]]" or "-3"
+func ParseValue(s string) (interface{}, error) {
+	p := &literalParser{lex: newLexer(s)}
+	p.next()
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s after value", p.tok)
+	}
+	return value, nil
+}
+
+// ParseValues parses a sequence of values separated by commas or only by
+// whitespace, such as the two lists on separate lines that make up the input
+// of a design problem. It returns the values and their byte offsets.
+func ParseValues(s string) ([]interface{}, []int, error) {
+	p := &literalParser{lex: newLexer(s)}
+	p.next()
+
+	var values []interface{}
+	var offsets []int
+	for p.tok.kind != tokEOF {
+		offset := p.tok.offset
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+		offsets = append(offsets, offset)
+
+		if p.tok.kind == tokComma {
+			p.next()
+		}
+	}
+	return values, offsets, nil
+}
+
+// literalParser is a recursive descent parser over the lexer's tokens
+type literalParser struct {
+	lex    *lexer
+	tok    token
+	peeked *token
+}
+
+func (p *literalParser) next() {
+	if p.peeked != nil {
+		p.tok, p.peeked = *p.peeked, nil
+		return
+	}
+	p.tok = p.lex.scan()
+}
+
+func (p *literalParser) peek() token {
+	if p.peeked == nil {
+		tok := p.lex.scan()
+		p.peeked = &tok
+	}
+	return *p.peeked
+}
+
+func (p *literalParser) errorf(format string, args ...interface{}) error {
+	if p.tok.kind == tokError {
+		return &SyntaxError{Offset: p.tok.offset, Msg: p.tok.text}
+	}
+	return &SyntaxError{Offset: p.tok.offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *literalParser) parseValue() (interface{}, error) {
+	tok := p.tok
+
+	switch tok.kind {
+	case tokNumber:
+		p.next()
+		return parseNumber(tok)
+	case tokString:
+		p.next()
+		value, err := strconv.Unquote(joinWrappedLines(tok.text))
+		if err != nil {
+			return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("invalid string %s", tok.text)}
+		}
+		return value, nil
+	case tokChar:
+		p.next()
+		value, _, tail, err := strconv.UnquoteChar(tok.text[1:len(tok.text)-1], '\'')
+		if err != nil || tail != "" {
+			return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("invalid char %s", tok.text)}
+		}
+		return value, nil
+	case tokIdent:
+		p.next()
+		switch tok.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		case "_":
+			// Unspecified elements, as in "nums = [2,2,_,_]"
+			return nil, nil
+		}
+		return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("unexpected identifier %q", tok.text)}
+	case tokLBracket:
+		p.next()
+		return p.parseArray()
+	default:
+		return nil, p.errorf("expected a value, found %s", tok)
+	}
+}
+
+func (p *literalParser) parseArray() (interface{}, error) {
+	elems := []interface{}{}
+
+	if p.tok.kind == tokRBracket {
+		p.next()
+		return elems, nil
+	}
+
+	for {
+		elem, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+
+		switch p.tok.kind {
+		case tokComma:
+			p.next()
+		case tokRBracket:
+			p.next()
+			return typedSlice(elems), nil
+		default:
+			return nil, p.errorf("expected ',' or ']', found %s", p.tok)
+		}
+	}
+}
+
+// parseNumber converts a number token into an int or a float64.
+// Integers that don't fit in an int but fit in an int64 are returned as int64,
+// and integers outside the int64 range are rejected.
+func parseNumber(tok token) (interface{}, error) {
+	if strings.ContainsAny(tok.text, ".eE") {
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("invalid number %s", tok.text)}
+		}
+		return f, nil
+	}
+
+	n, err := strconv.ParseInt(tok.text, 10, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("integer %s overflows int64", tok.text)}
+		}
+		return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("invalid integer %s", tok.text)}
+	}
+
+	if n < math.MinInt || n > math.MaxInt {
+		return n, nil
+	}
+	return int(n), nil
+}
+
+// typedSlice converts the elements of a parsed array into a typed slice when
+// they all share the same type. Mixed integers are widened to int64, and
+// integers mixed with floats are widened to float64.
+func typedSlice(elems []interface{}) interface{} {
+	if len(elems) == 0 {
+		return elems
+	}
+
+	var elemType reflect.Type
+	numeric := true
+	for _, elem := range elems {
+		if elem == nil {
+			return elems
+		}
+
+		t := reflect.TypeOf(elem)
+		if t.Kind() != reflect.Int && t.Kind() != reflect.Int64 && t.Kind() != reflect.Float64 {
+			numeric = false
+		}
+
+		switch {
+		case elemType == nil:
+			elemType = t
+		case elemType == t:
+		case numeric:
+			// Mixed numbers are widened to int64, or to float64 when
+			// floats are involved
+			if elemType.Kind() == reflect.Float64 || t.Kind() == reflect.Float64 {
+				elemType = reflect.TypeOf(float64(0))
+			} else {
+				elemType = reflect.TypeOf(int64(0))
+			}
+		default:
+			return elems
+		}
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(elemType), len(elems), len(elems))
+	for i, elem := range elems {
+		result.Index(i).Set(reflect.ValueOf(elem).Convert(elemType))
+	}
+	return result.Interface()
+}
+
+// tokenKind identifies the type of a lexical token
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokError
+	tokIdent
+	tokNumber
+	tokString
+	tokChar
+	tokLBracket
+	tokRBracket
+	tokComma
+	tokEquals
+)
+
+// token is a lexical token with its byte offset in the input
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of input"
+	case tokError:
+		return t.text
+	default:
+		return strconv.Quote(t.text)
+	}
+}
+
+// lexer splits a LeetCode literal into tokens
+type lexer struct {
+	input string
+	pos   int
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: input}
+}
+
+func (l *lexer) scan() token {
+	// Skip whitespace
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		l.pos += size
+	}
+
+	start := l.pos
+	if start >= len(l.input) {
+		return token{kind: tokEOF, offset: start}
+	}
+
+	c := l.input[start]
+	switch {
+	case c == '[':
+		l.pos++
+		return token{kind: tokLBracket, text: "[", offset: start}
+	case c == ']':
+		l.pos++
+		return token{kind: tokRBracket, text: "]", offset: start}
+	case c == ',':
+		l.pos++
+		return token{kind: tokComma, text: ",", offset: start}
+	case c == '=':
+		l.pos++
+		return token{kind: tokEquals, text: "=", offset: start}
+	case c == '"' || c == '\'':
+		return l.scanQuoted(c)
+	case c == '-' || c == '+' || isDigit(c):
+		return l.scanNumber()
+	case c == '_' || isLetter(c):
+		for l.pos < len(l.input) && (l.input[l.pos] == '_' || isLetter(l.input[l.pos]) || isDigit(l.input[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokIdent, text: l.input[start:l.pos], offset: start}
+	}
+
+	r, size := utf8.DecodeRuneInString(l.input[start:])
+	l.pos += size
+	return token{kind: tokError, text: fmt.Sprintf("unexpected character %q", r), offset: start}
+}
+
+// joinWrappedLines joins the lines of a string literal wrapped across lines
+// of a test file, dropping the line breaks and the indentation of the
+// continuation lines
+func joinWrappedLines(s string) string {
+	if !strings.Contains(s, "\n") {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		if i > 0 {
+			line = strings.TrimLeft(line, " \t")
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "")
+}
+
+func (l *lexer) scanQuoted(quote byte) token {
+	start := l.pos
+	l.pos++
+
+	for l.pos < len(l.input) {
+		switch l.input[l.pos] {
+		case '\\':
+			l.pos += 2
+			continue
+		case quote:
+			l.pos++
+			kind := tokString
+			if quote == '\'' {
+				kind = tokChar
+			}
+			return token{kind: kind, text: l.input[start:l.pos], offset: start}
+		}
+		l.pos++
+	}
+
+	l.pos = len(l.input)
+	return token{kind: tokError, text: "unterminated quoted literal", offset: start}
+}
+
+func (l *lexer) scanNumber() token {
+	start := l.pos
+	if c := l.input[l.pos]; c == '-' || c == '+' {
+		l.pos++
+	}
+
+	digits := l.pos
+	for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+		l.pos++
+	}
+	if l.pos < len(l.input) && l.input[l.pos] == '.' {
+		l.pos++
+		for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+			l.pos++
+		}
+	}
+	if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+		l.pos++
+		if l.pos < len(l.input) && (l.input[l.pos] == '-' || l.input[l.pos] == '+') {
+			l.pos++
+		}
+		for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+			l.pos++
+		}
+	}
+
+	if l.pos == digits {
+		return token{kind: tokError, text: "expected digits after sign", offset: start}
+	}
+	return token{kind: tokNumber, text: l.input[start:l.pos], offset: start}
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
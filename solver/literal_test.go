@@ -0,0 +1,226 @@
+package solver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseLiteral(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Literal
+	}{
+		{
+			name:  "named values",
+			input: "nums = [2,7,11,15], target = 9",
+			want: Literal{
+				Params:  map[string]interface{}{"nums": []int{2, 7, 11, 15}, "target": 9},
+				Names:   []string{"nums", "target"},
+				Offsets: map[string]int{"nums": 7, "target": 29},
+			},
+		},
+		{
+			name:  "unnamed value and expected argument state",
+			input: "2, nums = [2,2,_,_]",
+			want: Literal{
+				Values:       []interface{}{2},
+				ValueOffsets: []int{0},
+				Params:       map[string]interface{}{"nums": []interface{}{2, 2, nil, nil}},
+				Names:        []string{"nums"},
+				Offsets:      map[string]int{"nums": 10},
+			},
+		},
+		{
+			name:  "nested arrays",
+			input: "grid = [[1,2],[3]], empty = [[],[1]]",
+			want: Literal{
+				Params: map[string]interface{}{
+					"grid":  [][]int{{1, 2}, {3}},
+					"empty": []interface{}{[]interface{}{}, []int{1}},
+				},
+				Names:   []string{"grid", "empty"},
+				Offsets: map[string]int{"grid": 7, "empty": 28},
+			},
+		},
+		{
+			name:  "chars and strings",
+			input: `board = ['a','\n'], s = "a, b", q = "say \"hi\""`,
+			want: Literal{
+				Params: map[string]interface{}{
+					"board": []rune{'a', '\n'},
+					"s":     "a, b",
+					"q":     `say "hi"`,
+				},
+				Names:   []string{"board", "s", "q"},
+				Offsets: map[string]int{"board": 8, "s": 24, "q": 36},
+			},
+		},
+		{
+			name:  "wrapped string",
+			input: "s = \"abcdefgh\n    ijkl\", k = 2",
+			want: Literal{
+				Params:  map[string]interface{}{"s": "abcdefghijkl", "k": 2},
+				Names:   []string{"s", "k"},
+				Offsets: map[string]int{"s": 4, "k": 29},
+			},
+		},
+		{
+			name:  "string wrapped with CRLF line breaks",
+			input: "\"ab\r\n\tcd\r\nef\"",
+			want: Literal{
+				Values:       []interface{}{"abcdef"},
+				ValueOffsets: []int{0},
+			},
+		},
+		{
+			name:  "scalars",
+			input: "true, false, null, -1.5, 9223372036854775807",
+			want: Literal{
+				Values:       []interface{}{true, false, nil, -1.5, 9223372036854775807},
+				ValueOffsets: []int{0, 6, 13, 19, 25},
+			},
+		},
+		{
+			name:  "numbers widened to float64",
+			input: "[1,2.5]",
+			want: Literal{
+				Values:       []interface{}{[]float64{1, 2.5}},
+				ValueOffsets: []int{0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLiteral(tt.input)
+			if err != nil {
+				t.Fatalf("ParseLiteral(%q) returned error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got.Values, tt.want.Values) {
+				t.Errorf("Values = %#v, want %#v", got.Values, tt.want.Values)
+			}
+			if !reflect.DeepEqual(got.ValueOffsets, tt.want.ValueOffsets) {
+				t.Errorf("ValueOffsets = %v, want %v", got.ValueOffsets, tt.want.ValueOffsets)
+			}
+			if !reflect.DeepEqual(got.Names, tt.want.Names) {
+				t.Errorf("Names = %v, want %v", got.Names, tt.want.Names)
+			}
+			for name, want := range tt.want.Params {
+				if !reflect.DeepEqual(got.Params[name], want) {
+					t.Errorf("Params[%q] = %#v, want %#v", name, got.Params[name], want)
+				}
+				if got.Offsets[name] != tt.want.Offsets[name] {
+					t.Errorf("Offsets[%q] = %d, want %d", name, got.Offsets[name], tt.want.Offsets[name])
+				}
+			}
+			if len(got.Params) != len(tt.want.Params) {
+				t.Errorf("got %d params, want %d", len(got.Params), len(tt.want.Params))
+			}
+		})
+	}
+}
+
+func TestParseLiteralErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+		msg    string
+	}{
+		{"integer overflow", "n = 99999999999999999999", 4, "integer 99999999999999999999 overflows int64"},
+		{"overflow in an array", "nums = [1,-99999999999999999999]", 10, "integer -99999999999999999999 overflows int64"},
+		{"duplicate parameter", "x = 1, x = 2", 7, `duplicate parameter "x"`},
+		{"unterminated string", `s = "abc`, 4, "unterminated quoted literal"},
+		{"invalid char", "c = 'ab'", 4, "invalid char 'ab'"},
+		{"missing comma", "nums = [1 2]", 10, `expected ',' or ']', found "2"`},
+		{"unknown identifier", "n = foo", 4, `unexpected identifier "foo"`},
+		{"missing value", "n = ", 4, "expected a value, found end of input"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseLiteral(tt.input)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseLiteral(%q) error = %v, want a *SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Offset != tt.offset || syntaxErr.Msg != tt.msg {
+				t.Errorf("ParseLiteral(%q) error = offset %d: %s, want offset %d: %s",
+					tt.input, syntaxErr.Offset, syntaxErr.Msg, tt.offset, tt.msg)
+			}
+		})
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		values  []interface{}
+		offsets []int
+	}{
+		{
+			name:    "design problem input on separate lines",
+			input:   "[\"LRUCache\",\"put\"]\n[[2],[1,1]]",
+			values:  []interface{}{[]string{"LRUCache", "put"}, [][]int{{2}, {1, 1}}},
+			offsets: []int{0, 19},
+		},
+		{
+			name:    "comma separated",
+			input:   "1, [null,_]",
+			values:  []interface{}{1, []interface{}{nil, nil}},
+			offsets: []int{0, 3},
+		},
+		{
+			name:    "wrapped string",
+			input:   "[\"get\n  Value\"]",
+			values:  []interface{}{[]string{"getValue"}},
+			offsets: []int{0},
+		},
+		{
+			name:  "empty",
+			input: "  ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, offsets, err := ParseValues(tt.input)
+			if err != nil {
+				t.Fatalf("ParseValues(%q) returned error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(values, tt.values) {
+				t.Errorf("values = %#v, want %#v", values, tt.values)
+			}
+			if !reflect.DeepEqual(offsets, tt.offsets) {
+				t.Errorf("offsets = %v, want %v", offsets, tt.offsets)
+			}
+		})
+	}
+}
+
+func TestParseValuesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+	}{
+		{"unclosed array", "[1,2", 4},
+		{"overflow in the second value", "[1]\n[99999999999999999999]", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseValues(tt.input)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseValues(%q) error = %v, want a *SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Errorf("ParseValues(%q) error at offset %d, want %d", tt.input, syntaxErr.Offset, tt.offset)
+			}
+		})
+	}
+}
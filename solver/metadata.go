@@ -0,0 +1,51 @@
+package solver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Difficulty is the LeetCode difficulty of a problem
+type Difficulty string
+
+// Difficulty levels
+const (
+	DifficultyEasy   Difficulty = "easy"
+	DifficultyMedium Difficulty = "medium"
+	DifficultyHard   Difficulty = "hard"
+)
+
+// ParseDifficulty parses the name of a difficulty level, ignoring case
+func ParseDifficulty(name string) (Difficulty, error) {
+	switch d := Difficulty(strings.ToLower(name)); d {
+	case DifficultyEasy, DifficultyMedium, DifficultyHard:
+		return d, nil
+	default:
+		return "", fmt.Errorf("unknown difficulty %q (expected easy, medium or hard)", name)
+	}
+}
+
+// tagPattern matches topic tags such as "hash-table"
+var tagPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ParseTags parses a list of topic tags separated by commas or spaces, such
+// as "array, hash-table"
+func ParseTags(list string) ([]string, error) {
+	tags := strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	for _, tag := range tags {
+		if !tagPattern.MatchString(tag) {
+			return nil, fmt.Errorf("invalid tag %q (use lowercase words joined by hyphens, e.g. hash-table)", tag)
+		}
+	}
+	return tags, nil
+}
+
+// TaggedProblem is implemented by solvers whose problem declares its
+// difficulty or topic tags
+type TaggedProblem interface {
+	// Difficulty returns the difficulty of the problem, or "" if unknown
+	Difficulty() Difficulty
+	// Tags returns the topic tags of the problem
+	Tags() []string
+}
@@ -1,11 +1,7 @@
 package solver
 
 import (
-	"fmt"
 	"log"
-	"os"
-	"path/filepath"
-	"strings"
 )
 
 // ProblemDiscovery discovers problems in the codebase
@@ -22,62 +18,30 @@ func NewProblemDiscovery(registry *Registry, loader *ProblemLoader) *ProblemDisc
 	}
 }
 
-// DiscoverProblems finds all problems in the problems directory and registers them
+// DiscoverProblems registers a solver for every problem bound in the
+// generated problems/bindings_gen.go. The bindings are compiled in, so the
+// problems are found wherever the binary runs from.
 func (pd *ProblemDiscovery) DiscoverProblems() {
-	// Check if problems directory exists
-	if _, err := os.Stat("problems"); os.IsNotExist(err) {
-		log.Println("Problems directory not found")
-		return
-	}
-
-	// Walk through all problem directories
-	err := filepath.WalkDir("problems", func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		// Skip the root problems directory and non-directories
-		if path == "problems" || !d.IsDir() {
-			return nil
-		}
-
-		// Skip subdirectories of problem directories
-		if strings.Count(path, string(os.PathSeparator)) > 1 {
-			return filepath.SkipDir
-		}
-
-		// Get the problem type from the directory name
-		problemType := ProblemType(filepath.Base(path))
-
-		// Check if this is a valid problem directory
-		mainFile := filepath.Join(path, fmt.Sprintf("%s.go", problemType))
-		if _, err := os.Stat(mainFile); os.IsNotExist(err) {
-			log.Printf("Main file not found for problem %s at %s", problemType, mainFile)
-			return nil
-		}
-
+	for _, problemType := range BoundProblems() {
 		// Create and register a solver for this problem
 		solver, err := pd.loader.CreateSolver(problemType)
 		if err != nil {
 			log.Printf("Warning: Could not create solver for %s: %v", problemType, err)
-			return nil
+			continue
 		}
 
-		// Register the solver
+		// Register the solver, and its checker if the problem has one
 		pd.registry.Register(problemType, solver)
+		if binding, exists := LookupBinding(problemType); exists && binding.checker() != nil {
+			pd.registry.RegisterChecker(problemType, binding.checker())
+		}
 		log.Printf("Registered solver for problem: %s", problemType)
-
-		return nil
-	})
-
-	if err != nil {
-		log.Printf("Error walking through problems directory: %v", err)
 	}
 }
 
 // AutoRegisterSolvers is a helper method to register all known solvers
 func (pd *ProblemDiscovery) AutoRegisterSolvers() {
-	// First, discover problems in the problems directory
+	// First, discover the problems bound in the problems package
 	pd.DiscoverProblems()
 
 	// You could add additional registration methods here, e.g., for built-in solvers
@@ -2,87 +2,503 @@ package solver
 
 import (
 	"fmt"
+	"reflect"
 )
 
-// ReflectiveSolver uses reflection to call problem-specific functions
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ReflectiveSolver uses reflection to call the solution function bound to a problem
 type ReflectiveSolver struct {
 	problemType ProblemType
+	fn          reflect.Value
+	params      []string
+	compare     CompareMode
+	// inPlace is the index of the argument modified in place, or -1
+	inPlace int
+	limits  Limits
+	parser  TestCaseParser
+	// encoding builds the arguments from the inputs, if the problem has one
+	encoding Encoding
+	// graph is the edge list parameter, if the problem has one
+	graph GraphParam
+	// design is set for design problems, whose function is a constructor
+	design bool
+	// judge sets up the API of interactive problems
+	judge JudgeFunc
+	// difficulty and tags describe the problem for test selection
+	difficulty Difficulty
+	tags       []string
 }
 
-// NewReflectiveSolver creates a new reflective solver for the given problem type
-func NewReflectiveSolver(problemType ProblemType) *ReflectiveSolver {
-	return &ReflectiveSolver{
-		problemType: problemType,
+// NewReflectiveSolver creates a new reflective solver for the given binding.
+// Test cases are parsed with the given parser.
+func NewReflectiveSolver(b Binding, parser TestCaseParser) (*ReflectiveSolver, error) {
+	if err := validateBinding(b); err != nil {
+		return nil, err
 	}
+
+	var encoding Encoding
+	if b.Encoding != "" {
+		encoding, _ = LookupEncoding(b.Encoding)
+	}
+
+	return &ReflectiveSolver{
+		problemType: b.Problem,
+		fn:          reflect.ValueOf(b.Func),
+		params:      b.Params,
+		compare:     b.Compare,
+		inPlace:     paramIndex(b.Params, b.InPlace),
+		limits:      b.Limits,
+		parser:      parser,
+		difficulty:  b.Difficulty,
+		tags:        b.Tags,
+		encoding:    encoding,
+		graph:       b.Graph,
+		design:      b.Design,
+		judge:       b.Judge,
+	}, nil
 }
 
 // Solve implements the Problem interface
 func (s *ReflectiveSolver) Solve(params map[string]interface{}) (interface{}, error) {
-	// Based on the problem type, call the appropriate handler
-	switch s.problemType {
-	case "merge_array":
-		return s.handleMergeArray(params)
-	case "two_sum":
-		return s.handleTwoSum(params)
-	case "remove_element":
-		return s.handleRemoveElement(params)
+	if s.judge != nil {
+		return s.solveInteractive(params)
+	}
+	return s.solve(params)
+}
+
+// solve calls the solution with the test case inputs and converts its results
+func (s *ReflectiveSolver) solve(params map[string]interface{}) (interface{}, error) {
+	if s.design {
+		return s.replay(params)
+	}
+
+	fnType := s.fn.Type()
+
+	args, err := s.args(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call the solution and convert the results back
+	results := s.fn.Call(args)
+
+	if n := len(results); n > 0 && fnType.Out(n-1) == errorType {
+		if err, _ := results[n-1].Interface().(error); err != nil {
+			return nil, err
+		}
+		results = results[:n-1]
+	}
+
+	// Results of encoded problems are judged in the encoding's terms, e.g. a
+	// returned node as its index in the input list
+	if s.encoding != nil && len(results) == 1 {
+		return s.encoding.Output(results[0], args)
+	}
+
+	// In-place problems are judged on the modified argument, or on the
+	// returned k and the first k elements of the argument
+	if s.inPlace >= 0 {
+		if len(results) == 0 {
+			return cloneValue(args[s.inPlace]).Interface(), nil
+		}
+		return prefixResult(s.params[s.inPlace], args[s.inPlace], results[0])
+	}
+
+	switch len(results) {
+	case 0:
+		return nil, nil
+	case 1:
+		return results[0].Interface(), nil
 	default:
-		return nil, fmt.Errorf("unknown problem type: %s", s.problemType)
+		values := make([]interface{}, len(results))
+		for i, result := range results {
+			values[i] = result.Interface()
+		}
+		return values, nil
 	}
 }
 
-// handleMergeArray handles the merge array problem
-func (s *ReflectiveSolver) handleMergeArray(params map[string]interface{}) (interface{}, error) {
-	// Extract parameters
-	nums1, ok1 := params["nums1"].([]int)
-	m, ok2 := params["m"].(int)
-	nums2, ok3 := params["nums2"].([]int)
-	n, ok4 := params["n"].(int)
+// args converts the input parameters into the typed arguments of a call
+func (s *ReflectiveSolver) args(params map[string]interface{}) ([]reflect.Value, error) {
+	fnType := s.fn.Type()
+	if s.encoding != nil {
+		return s.encoding.Args(fnType, params)
+	}
+
+	args := make([]reflect.Value, len(s.params))
+	for i, name := range s.params {
+		value, ok := params[name]
+		if !ok {
+			return nil, fmt.Errorf("missing parameter %q for problem %s", name, s.problemType)
+		}
+
+		// Graphs are built afresh from their edges for every call
+		if name == s.graph.Param {
+			arg, err := s.graph.build(params, fnType.In(i))
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+			continue
+		}
+
+		arg, err := convertValue(value, fnType.In(i))
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
 
-	if !ok1 || !ok2 || !ok3 || !ok4 {
-		return nil, fmt.Errorf("invalid parameters for merge array problem")
+		// Work on a copy so in-place modifications don't change the test
+		// case, which may be run more than once
+		args[i] = cloneValue(arg)
 	}
+	return args, nil
+}
 
-	// Create a copy of nums1 to avoid modifying the original
-	result := make([]int, len(nums1))
-	copy(result, nums1)
+// CompareOutput implements the Comparator interface using the comparison
+// mode declared by the problem
+func (s *ReflectiveSolver) CompareOutput(expected, actual interface{}) bool {
+	expectedPrefix, ok1 := expected.(PrefixResult)
+	actualPrefix, ok2 := actual.(PrefixResult)
+	if ok1 && ok2 {
+		return equalPrefixResults(s.compare, expectedPrefix, actualPrefix)
+	}
+	expectedDesign, ok1 := expected.(DesignResult)
+	actualDesign, ok2 := actual.(DesignResult)
+	if ok1 && ok2 {
+		return equalDesignResults(s.compare, expectedDesign, actualDesign)
+	}
+	return s.compare.Equal(expected, actual)
+}
+
+// Diff implements the Differ interface using the comparison mode declared
+// by the problem
+func (s *ReflectiveSolver) Diff(expected, actual interface{}) *Diff {
+	expectedPrefix, ok1 := expected.(PrefixResult)
+	actualPrefix, ok2 := actual.(PrefixResult)
+	if ok1 && ok2 {
+		return diffPrefixResults(s.compare, expectedPrefix, actualPrefix)
+	}
+	expectedDesign, ok1 := expected.(DesignResult)
+	actualDesign, ok2 := actual.(DesignResult)
+	if ok1 && ok2 {
+		return diffDesignResults(s.compare, expectedDesign, actualDesign)
+	}
+	return s.compare.Diff(expected, actual)
+}
+
+// Limits implements the LimitedProblem interface
+func (s *ReflectiveSolver) Limits() Limits {
+	return s.limits
+}
 
-	// Use direct implementation
-	if n == 0 {
-		return result, nil
+// Difficulty implements the TaggedProblem interface
+func (s *ReflectiveSolver) Difficulty() Difficulty {
+	return s.difficulty
+}
+
+// Tags implements the TaggedProblem interface
+func (s *ReflectiveSolver) Tags() []string {
+	return s.tags
+}
+
+// ParseTestCases implements the TestCaseParser interface.
+// Unless the problem has a custom parser, the input and output of every
+// example are parsed with the generic LeetCode literal parser and converted
+// to the parameter and result types of the solution function.
+func (s *ReflectiveSolver) ParseTestCases(filePath string) ([]TestCase, error) {
+	if s.parser != nil {
+		return s.parser.ParseTestCases(filePath)
 	}
 
-	lastNum1 := m - 1
-	lastNum2 := n - 1
-	lastMerge := m + n - 1
+	examples, err := ReadExamples(filePath)
+	if err != nil {
+		return nil, err
+	}
 
-	for lastNum2 >= 0 {
-		if lastNum1 >= 0 && nums1[lastNum1] > nums2[lastNum2] {
-			result[lastMerge] = nums1[lastNum1]
-			lastNum1--
-		} else {
-			result[lastMerge] = nums2[lastNum2]
-			lastNum2--
+	testCases := make([]TestCase, 0, len(examples))
+	for i, example := range examples {
+		testCase, err := s.parseExample(filePath, example)
+		if err != nil {
+			return nil, err
 		}
-		lastMerge--
+		testCase.Name = CaseName(filePath, i, len(examples))
+		testCases = append(testCases, testCase)
 	}
 
-	return result, nil
+	return testCases, nil
 }
 
-// handleTwoSum handles the two sum problem
-func (s *ReflectiveSolver) handleTwoSum(params map[string]interface{}) (interface{}, error) {
-	// We'll implement this later
-	return []int{0, 0}, fmt.Errorf("two sum implementation not available")
+// parseExample parses one Input/Output pair of a test file
+func (s *ReflectiveSolver) parseExample(filePath string, example Example) (TestCase, error) {
+	if s.design {
+		return s.parseDesignExample(filePath, example)
+	}
+
+	testCase := TestCase{
+		FilePath:       filePath,
+		ProblemType:    s.problemType,
+		InputParams:    make(map[string]interface{}),
+		ExpectedParams: make(map[string]interface{}),
+	}
+	inputSection, outputSection := example.Input, example.Output
+
+	// Parse input parameters
+	input, err := ParseLiteral(inputSection.Text)
+	if err != nil {
+		return testCase, inputSection.wrapError(filePath, "invalid input", err)
+	}
+	if len(input.Values) > 0 {
+		return testCase, inputSection.Errorf(filePath, input.ValueOffsets[0],
+			"invalid input: all input values must be named")
+	}
+
+	testCase.InputNames = input.Names
+	for name, value := range input.Params {
+		testCase.InputParams[name] = value
+	}
+	if s.encoding != nil {
+		// Encoded inputs are kept as parsed and turned into arguments for
+		// every call. They are built once here to report invalid inputs.
+		for _, name := range s.encoding.Inputs() {
+			if _, ok := input.Params[name]; !ok {
+				return testCase, inputSection.Errorf(filePath, 0, "invalid input: missing input %q", name)
+			}
+		}
+		if _, err := s.encoding.Args(s.fn.Type(), input.Params); err != nil {
+			return testCase, inputSection.Errorf(filePath, 0, "invalid input: %v", err)
+		}
+	} else {
+		for _, name := range s.params {
+			value, ok := input.Params[name]
+			if !ok {
+				return testCase, inputSection.Errorf(filePath, 0, "invalid input: missing parameter %q", name)
+			}
+
+			arg, err := s.convertParam(name, value)
+			if err != nil {
+				return testCase, inputSection.Errorf(filePath, input.Offsets[name], "invalid input: %v", err)
+			}
+			testCase.InputParams[name] = arg
+		}
+
+		// Edge lists are kept as such for checkers, and the graph is built
+		// once here to report invalid edges
+		if s.graph.Param != "" {
+			if _, err := s.graph.build(testCase.InputParams, s.fn.Type().In(paramIndex(s.params, s.graph.Param))); err != nil {
+				return testCase, inputSection.Errorf(filePath, input.Offsets[s.graph.Param], "invalid input: %v", err)
+			}
+		}
+	}
+
+	// Parse expected output
+	output, err := ParseLiteral(outputSection.Text)
+	if err != nil {
+		return testCase, outputSection.wrapError(filePath, "invalid output", err)
+	}
+	if len(output.Values) > 1 {
+		return testCase, outputSection.Errorf(filePath, output.ValueOffsets[1],
+			"invalid output: expected a single unnamed value, found %d", len(output.Values))
+	}
+
+	if len(output.Values) == 1 {
+		expected := output.Values[0]
+		if resultType, ok := s.resultType(); ok {
+			if s.encoding != nil {
+				resultType = s.encoding.OutputType(resultType)
+			}
+			converted, err := convertValue(expected, resultType)
+			if err != nil {
+				return testCase, outputSection.Errorf(filePath, output.ValueOffsets[0], "invalid output: %v", err)
+			}
+			expected = converted.Interface()
+		}
+		testCase.ExpectedOutput = expected
+	}
+
+	for name, value := range output.Params {
+		// Only the first k elements of an in-place argument are specified,
+		// the rest are usually given as "_"
+		if s.inPlace >= 0 && name == s.params[s.inPlace] {
+			k, _ := testCase.ExpectedOutput.(int)
+			prefix, err := truncateList(value, k)
+			if err != nil {
+				return testCase, outputSection.Errorf(filePath, output.Offsets[name], "invalid output: %v", err)
+			}
+			value = prefix
+		}
+
+		if _, isParam := input.Params[name]; isParam {
+			converted, err := s.convertParam(name, value)
+			if err != nil {
+				return testCase, outputSection.Errorf(filePath, output.Offsets[name], "invalid output: %v", err)
+			}
+			value = converted
+		}
+		testCase.ExpectedParams[name] = value
+	}
+
+	if s.inPlace >= 0 && s.fn.Type().NumOut() == 0 {
+		// The output of a void in-place problem is the modified argument
+		if len(output.Values) != 1 {
+			return testCase, outputSection.Errorf(filePath, 0, "invalid output: expected the value of the modified argument")
+		}
+
+		expected, err := s.convertParam(s.params[s.inPlace], output.Values[0])
+		if err != nil {
+			return testCase, outputSection.Errorf(filePath, output.ValueOffsets[0], "invalid output: %v", err)
+		}
+		testCase.ExpectedOutput = expected
+	} else if s.inPlace >= 0 {
+		if len(output.Values) != 1 {
+			return testCase, outputSection.Errorf(filePath, 0, "invalid output: expected the returned k")
+		}
+
+		name := s.params[s.inPlace]
+		testCase.ExpectedOutput = PrefixResult{
+			K:      testCase.ExpectedOutput.(int),
+			Param:  name,
+			Prefix: testCase.ExpectedParams[name],
+		}
+	}
+
+	return testCase, nil
 }
 
-// handleRemoveElement handles the remove element problem
-func (s *ReflectiveSolver) handleRemoveElement(params map[string]interface{}) (interface{}, error) {
-	// We'll implement this later
-	result := map[string]interface{}{
-		"length": 0,
-		"array":  []int{},
+// convertParam converts a parsed value to the type of the named parameter
+func (s *ReflectiveSolver) convertParam(name string, value interface{}) (interface{}, error) {
+	for i, param := range s.params {
+		if param != name {
+			continue
+		}
+
+		t := s.fn.Type().In(i)
+		if name == s.graph.Param {
+			t = edgesType
+		}
+		arg, err := convertValue(value, t)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		return arg.Interface(), nil
+	}
+	return value, nil
+}
+
+// resultType returns the type of the value returned by the solution function,
+// ignoring a trailing error. It reports false for functions without exactly
+// one result.
+func (s *ReflectiveSolver) resultType() (reflect.Type, bool) {
+	fnType := s.fn.Type()
+
+	n := fnType.NumOut()
+	if n > 0 && fnType.Out(n-1) == errorType {
+		n--
+	}
+	if n != 1 {
+		return nil, false
+	}
+	return fnType.Out(0), true
+}
+
+// validateBinding checks that a binding refers to a function whose parameters
+// match the declared parameter names
+func validateBinding(b Binding) error {
+	if b.Problem == "" {
+		return fmt.Errorf("empty problem type")
+	}
+
+	if _, err := ParseCompareMode(string(b.Compare)); err != nil {
+		return err
+	}
+	if b.Difficulty != "" {
+		if _, err := ParseDifficulty(string(b.Difficulty)); err != nil {
+			return err
+		}
+	}
+	if b.Limits.Time < 0 {
+		return fmt.Errorf("negative time limit %v", b.Limits.Time)
+	}
+	if b.Limits.Memory < 0 {
+		return fmt.Errorf("negative memory limit %d", int64(b.Limits.Memory))
+	}
+	if b.Limits.Calls < 0 {
+		return fmt.Errorf("negative call budget %d", b.Limits.Calls)
+	}
+	if b.Limits.Calls > 0 && b.Judge == nil {
+		return fmt.Errorf("a call budget needs a judge")
+	}
+
+	fn := reflect.ValueOf(b.Func)
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return fmt.Errorf("expected a function, got %T", b.Func)
+	}
+
+	fnType := fn.Type()
+	if fnType.IsVariadic() {
+		return fmt.Errorf("variadic functions are not supported")
+	}
+	if fnType.NumIn() != len(b.Params) {
+		return fmt.Errorf("function takes %d parameters but %d names were given",
+			fnType.NumIn(), len(b.Params))
+	}
+
+	if b.Encoding != "" {
+		encoding, ok := LookupEncoding(b.Encoding)
+		if !ok {
+			return fmt.Errorf("unknown encoding %q (expected one of %v)", b.Encoding, EncodingNames())
+		}
+		if b.InPlace != "" {
+			return fmt.Errorf("encoded problems can't modify an argument in place")
+		}
+		if err := encoding.Check(fnType); err != nil {
+			return fmt.Errorf("encoding %q: %w", b.Encoding, err)
+		}
+	}
+
+	if b.Design {
+		if _, err := designClass(fnType); err != nil {
+			return err
+		}
+		if b.InPlace != "" || b.Encoding != "" || b.Graph != (GraphParam{}) {
+			return fmt.Errorf("design problems can't modify arguments in place or have an encoding or a graph parameter")
+		}
+		if b.Judge != nil {
+			return fmt.Errorf("design problems can't have a judge")
+		}
+	}
+
+	if b.Graph != (GraphParam{}) {
+		if err := validateGraphParam(b.Graph, b.Params, fnType); err != nil {
+			return err
+		}
+		if b.Encoding != "" {
+			return fmt.Errorf("encoded problems can't have a graph parameter")
+		}
+	}
+
+	if b.InPlace != "" {
+		i := paramIndex(b.Params, b.InPlace)
+		if i < 0 {
+			return fmt.Errorf("in-place parameter %q is not a parameter of the function", b.InPlace)
+		}
+		if fnType.In(i).Kind() != reflect.Slice {
+			return fmt.Errorf("in-place parameter %q must be a slice, got %s", b.InPlace, fnType.In(i))
+		}
+		if fnType.NumOut() > 1 || fnType.NumOut() == 1 && fnType.Out(0) != reflect.TypeOf(0) {
+			return fmt.Errorf("in-place problems must return nothing or the number of elements k as an int")
+		}
 	}
 
-	return result, fmt.Errorf("remove element implementation not available")
+	return nil
+}
+
+// paramIndex returns the index of a parameter name, or -1 if it is not found
+func paramIndex(params []string, name string) int {
+	for i, param := range params {
+		if param == name {
+			return i
+		}
+	}
+	return -1
 }
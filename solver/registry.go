@@ -2,6 +2,7 @@ package solver
 
 import (
 	"log"
+	"sync"
 )
 
 // ProblemType represents the type of problem to solve
@@ -14,9 +15,12 @@ type Problem interface {
 	Solve(params map[string]interface{}) (interface{}, error)
 }
 
-// Registry maintains a mapping of problem types to their solvers
+// Registry maintains a mapping of problem types to their solvers. It is safe
+// for concurrent use.
 type Registry struct {
+	mu               sync.RWMutex
 	solvers          map[ProblemType]Problem
+	checkers         map[ProblemType]Checker
 	loader           *ProblemLoader
 	problemDiscovery *ProblemDiscovery
 }
@@ -24,7 +28,8 @@ type Registry struct {
 // NewRegistry creates a new registry instance
 func NewRegistry() *Registry {
 	registry := &Registry{
-		solvers: make(map[ProblemType]Problem),
+		solvers:  make(map[ProblemType]Problem),
+		checkers: make(map[ProblemType]Checker),
 	}
 
 	// Create a loader
@@ -38,17 +43,50 @@ func NewRegistry() *Registry {
 
 // Register adds a problem solver to the registry
 func (r *Registry) Register(problemType ProblemType, solver Problem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.solvers[problemType] = solver
 }
 
 // Get retrieves a problem solver from the registry
 func (r *Registry) Get(problemType ProblemType) (Problem, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	solver, exists := r.solvers[problemType]
 	return solver, exists
 }
 
+// RegisterChecker adds an output checker for a problem to the registry
+func (r *Registry) RegisterChecker(problemType ProblemType, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkers[problemType] = checker
+}
+
+// Checker retrieves the output checker for a problem. Problems without a
+// registered checker use their solver's comparison mode, or exact equality
+// if the solver doesn't implement Comparator.
+func (r *Registry) Checker(problemType ProblemType) Checker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if checker, exists := r.checkers[problemType]; exists {
+		return checker
+	}
+	if comparator, ok := r.solvers[problemType].(Comparator); ok {
+		return comparatorChecker{comparator: comparator}
+	}
+	return CompareChecker{Mode: CompareExact}
+}
+
 // ListRegisteredProblems returns a list of all registered problem types
 func (r *Registry) ListRegisteredProblems() []ProblemType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	problems := make([]ProblemType, 0, len(r.solvers))
 	for problemType := range r.solvers {
 		problems = append(problems, problemType)
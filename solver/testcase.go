@@ -2,108 +2,263 @@ package solver
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
-	"log"
 	"os"
+	"path/filepath"
 	"regexp"
-	"strconv"
+	"sort"
 	"strings"
 )
 
 // TestCase represents a generic test case
 type TestCase struct {
-	FilePath       string
-	ProblemType    ProblemType
-	InputParams    map[string]interface{}
+	// Name identifies the case in reports, e.g. "test1.txt" or "test1.txt#2"
+	// for the second example of a file
+	Name        string
+	FilePath    string
+	ProblemType ProblemType
+	InputParams map[string]interface{}
+	// InputNames are the names of the input parameters in the order of the
+	// "Input:" line
+	InputNames     []string
 	ExpectedOutput interface{}
+	// ExpectedParams holds named values from the output line, such as
+	// "nums = [2,2]" in "Output: 2, nums = [2,2]". They describe the
+	// expected state of the arguments after the call.
+	ExpectedParams map[string]interface{}
+}
+
+// FormatInput formats the input parameters in the order of the "Input:" line,
+// e.g. "nums = [2 7 11 15], target = 9"
+func (tc TestCase) FormatInput() string {
+	names := tc.InputNames
+	if len(names) != len(tc.InputParams) {
+		names = make([]string, 0, len(tc.InputParams))
+		for name := range tc.InputParams {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s = %v", name, tc.InputParams[name])
+	}
+	return strings.Join(parts, ", ")
 }
 
 // TestCaseParser is the interface for problem-specific test case parsers
 type TestCaseParser interface {
-	// ParseTestCase parses a test file into a TestCase structure
-	ParseTestCase(filePath string) (TestCase, error)
+	// ParseTestCases parses every example of a test file into TestCase structures
+	ParseTestCases(filePath string) ([]TestCase, error)
+}
+
+// Section is the text following a header such as "Input:" in a test file,
+// together with the position where the text starts
+type Section struct {
+	Text   string
+	Line   int
+	Column int
+}
+
+// Position returns the line and column of a byte offset in the section text
+func (sec Section) Position(offset int) (int, int) {
+	if offset > len(sec.Text) {
+		offset = len(sec.Text)
+	}
+
+	line, column := sec.Line, sec.Column+offset
+	if i := strings.LastIndexByte(sec.Text[:offset], '\n'); i >= 0 {
+		line += strings.Count(sec.Text[:offset], "\n")
+		column = offset - i
+	}
+	return line, column
+}
+
+// ParseError is a test case parse error with its location in the test file
+type ParseError struct {
+	File   string
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Msg)
+}
+
+// Errorf returns a ParseError located at a byte offset in the section text
+func (sec Section) Errorf(filePath string, offset int, format string, args ...interface{}) *ParseError {
+	line, column := sec.Position(offset)
+	return &ParseError{
+		File:   filePath,
+		Line:   line,
+		Column: column,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// wrapError converts an error from parsing the section text into a ParseError,
+// using the offset of a SyntaxError when available
+func (sec Section) wrapError(filePath, context string, err error) error {
+	var syntaxErr *SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return sec.Errorf(filePath, syntaxErr.Offset, "%s: %s", context, syntaxErr.Msg)
+	}
+	return sec.Errorf(filePath, 0, "%s: %v", context, err)
 }
 
-// ProblemWithParser is an extended interface that includes test case parsing
-type ProblemWithParser interface {
-	Problem
-	TestCaseParser
+// Example is one Input/Output pair of a test file
+type Example struct {
+	Input  Section
+	Output Section
 }
 
-// ReadInputAndOutput reads input and output lines from a test file
-func ReadInputAndOutput(filePath string) (string, string, error) {
+// CaseName returns the name of the index-th (0-based) of total examples in a
+// test file, e.g. "test1.txt" for a single example or "test1.txt#2"
+func CaseName(filePath string, index, total int) string {
+	name := filepath.Base(filePath)
+	if total > 1 {
+		name = fmt.Sprintf("%s#%d", name, index+1)
+	}
+	return name
+}
+
+// sectionHeader matches the section headers of the problem page: "Input:",
+// "Output:", "Explanation:", "Example 1:", "Constraints:", "Note:" and
+// "Follow-up:". Other capitalized words followed by a colon, such as "Hello:"
+// on a line of a wrapped string, are part of the value.
+var sectionHeader = regexp.MustCompile(`^(Input|Output|Explanation|Example(?:\s*\d+)?|Constraints|Note|Follow[ -]?up)\s*:`)
+
+// bareHeader matches the "Input" and "Output" headers of design problem
+// examples, which LeetCode prints without a colon on a line of their own
+var bareHeader = regexp.MustCompile(`^(Input|Output)$`)
+
+// ReadExamples reads every Input/Output pair from a test file.
+//
+// Files contain one or more consecutive "Input:"/"Output:" blocks. A value
+// continues on the following lines until the next section header, so wrapped
+// matrices and long strings can be pasted as is. A blank line ends a value
+// once its brackets are balanced. Other sections such as "Example N:" headers
+// and "Explanation:" lines are ignored. The examples of design problems may
+// also use "Input" and "Output" on lines of their own, as LeetCode prints them.
+func ReadExamples(filePath string) ([]Example, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open file: %w", err)
+		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 	defer file.Close()
 
-	var inputLine, outputLine string
+	var examples []Example
+	var current *Example
+	// section is the value receiving continuation lines, if any
+	var section *Section
 	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNum := 0
 
-	// Read input line
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, "Input:") {
-			inputLine = strings.TrimPrefix(line, "Input:")
-			break
+	// closeSection ends the current value and completes the example once
+	// its output has been read
+	closeSection := func() {
+		if current != nil && section == &current.Output {
+			examples = append(examples, *current)
+			current = nil
 		}
+		section = nil
 	}
 
-	// Read output line
 	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, "Output:") {
-			outputLine = strings.TrimPrefix(line, "Output:")
-			break
-		}
-	}
+		lineNum++
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		indent := strings.Index(raw, line)
 
-	if err := scanner.Err(); err != nil {
-		return "", "", fmt.Errorf("error reading file: %w", err)
-	}
+		match := sectionHeader.FindStringSubmatch(line)
+		if match == nil {
+			match = bareHeader.FindStringSubmatch(line)
+		}
+		if match != nil {
+			closeSection()
 
-	return inputLine, outputLine, nil
-}
+			start := Section{
+				Text:   line[len(match[0]):],
+				Line:   lineNum,
+				Column: indent + len(match[0]) + 1,
+			}
 
-// ParseIntArray converts a comma-separated string of integers to an int slice
-func ParseIntArray(s string) []int {
-	parts := strings.Split(s, ",")
-	result := make([]int, 0, len(parts))
+			switch match[1] {
+			case "Input":
+				if current != nil {
+					return nil, &ParseError{File: filePath, Line: current.Input.Line, Column: current.Input.Column,
+						Msg: "Input: without a matching Output:"}
+				}
+				current = &Example{Input: start}
+				section = &current.Input
+			case "Output":
+				if current == nil {
+					return nil, &ParseError{File: filePath, Line: lineNum, Column: indent + 1,
+						Msg: "Output: without a preceding Input:"}
+				}
+				current.Output = start
+				section = &current.Output
+			}
+			continue
+		}
 
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
+		if section == nil {
+			// Not part of the test data
 			continue
 		}
-		num, err := strconv.Atoi(part)
-		if err != nil {
-			log.Printf("Warning: could not parse '%s' as integer, skipping", part)
+
+		if line == "" {
+			if strings.TrimSpace(section.Text) != "" && bracketDepth(section.Text) <= 0 {
+				closeSection()
+			}
 			continue
 		}
-		result = append(result, num)
+		section.Text += "\n" + raw
 	}
 
-	return result
-}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
 
-// ExtractArrayFromBrackets extracts an array from a string with the format [...].
-// It returns the array as a string, without the brackets.
-func ExtractArrayFromBrackets(s string) (string, error) {
-	re := regexp.MustCompile(`\[(.*?)\]`)
-	match := re.FindStringSubmatch(s)
-	if len(match) < 2 {
-		return "", fmt.Errorf("no array found in string: %s", s)
+	closeSection()
+	if current != nil {
+		return nil, &ParseError{File: filePath, Line: current.Input.Line, Column: current.Input.Column,
+			Msg: "Input: without a matching Output:"}
 	}
-	return match[1], nil
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("%s: no Input:/Output: pair found", filePath)
+	}
+
+	return examples, nil
 }
 
-// ExtractIntValue extracts an integer value from a string parameter (e.g., "target = 9")
-func ExtractIntValue(s, paramName string) (int, error) {
-	re := regexp.MustCompile(paramName + `\s*=\s*(\d+)`)
-	match := re.FindStringSubmatch(s)
-	if len(match) < 2 {
-		return 0, fmt.Errorf("no %s value found in: %s", paramName, s)
+// bracketDepth returns the number of unclosed brackets in s, ignoring
+// brackets inside quoted strings and chars
+func bracketDepth(s string) int {
+	depth := 0
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		}
 	}
-	return strconv.Atoi(match[1])
+
+	return depth
 }
@@ -0,0 +1,155 @@
+package solver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeTestFile writes a test file into a temporary directory and returns its
+// path
+func writeTestFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test1.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadExamples(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Example
+	}{
+		{
+			name:    "single example",
+			content: "Input: nums = [2,7,11,15], target = 9\nOutput: [0,1]\n",
+			want: []Example{{
+				Input:  Section{Text: " nums = [2,7,11,15], target = 9", Line: 1, Column: 7},
+				Output: Section{Text: " [0,1]", Line: 2, Column: 8},
+			}},
+		},
+		{
+			name: "examples pasted from the problem page",
+			content: "Example 1:\n\nInput: n = 3\nOutput: 6\nExplanation: 1 + 2 + 3 = 6.\n\n" +
+				"Example 2:\n\n  Input: n = 1\n  Output: 1\n\nConstraints:\n1 <= n <= 100\n" +
+				"Note: the sum\nFollow-up: without a loop?\n",
+			want: []Example{
+				{
+					Input:  Section{Text: " n = 3", Line: 3, Column: 7},
+					Output: Section{Text: " 6", Line: 4, Column: 8},
+				},
+				{
+					Input:  Section{Text: " n = 1", Line: 9, Column: 9},
+					Output: Section{Text: " 1", Line: 10, Column: 10},
+				},
+			},
+		},
+		{
+			name:    "wrapped matrix with blank lines inside brackets",
+			content: "Input:\ngrid = [[1,3],\n\n        [4,2]]\n\nOutput: 7\n",
+			want: []Example{{
+				Input:  Section{Text: "\ngrid = [[1,3],\n        [4,2]]", Line: 1, Column: 7},
+				Output: Section{Text: " 7", Line: 6, Column: 8},
+			}},
+		},
+		{
+			name:    "wrapped string with a colon",
+			content: "Input: s = \"abcdefgh\nHello: ijkl\"\nOutput: true\n",
+			want: []Example{{
+				Input:  Section{Text: " s = \"abcdefgh\nHello: ijkl\"", Line: 1, Column: 7},
+				Output: Section{Text: " true", Line: 3, Column: 8},
+			}},
+		},
+		{
+			name:    "design problem headers on lines of their own",
+			content: "Input\n[\"MinStack\",\"push\"]\n[[],[1]]\nOutput\n[null,null]\n",
+			want: []Example{{
+				Input:  Section{Text: "\n[\"MinStack\",\"push\"]\n[[],[1]]", Line: 1, Column: 6},
+				Output: Section{Text: "\n[null,null]", Line: 4, Column: 7},
+			}},
+		},
+		{
+			name:    "text between examples is ignored",
+			content: "Input: a = 1\nOutput: 1\n\nsome notes\n\nInput: a = 2\nOutput: 2\n",
+			want: []Example{
+				{
+					Input:  Section{Text: " a = 1", Line: 1, Column: 7},
+					Output: Section{Text: " 1", Line: 2, Column: 8},
+				},
+				{
+					Input:  Section{Text: " a = 2", Line: 6, Column: 7},
+					Output: Section{Text: " 2", Line: 7, Column: 8},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadExamples(writeTestFile(t, tt.content))
+			if err != nil {
+				t.Fatalf("ReadExamples returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadExamples = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadExamplesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int
+		column  int
+		msg     string
+	}{
+		{"input without output", "Input: a = 1\nInput: a = 2\nOutput: 2\n", 1, 7, "Input: without a matching Output:"},
+		{"output without input", "Explanation: none\n  Output: 2\n", 2, 3, "Output: without a preceding Input:"},
+		{"unfinished last example", "Input: a = 1\nOutput: 1\nInput: a = 2\n", 3, 7, "Input: without a matching Output:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadExamples(writeTestFile(t, tt.content))
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ReadExamples error = %v, want a *ParseError", err)
+			}
+			if parseErr.Line != tt.line || parseErr.Column != tt.column || parseErr.Msg != tt.msg {
+				t.Errorf("ReadExamples error = %d:%d: %s, want %d:%d: %s",
+					parseErr.Line, parseErr.Column, parseErr.Msg, tt.line, tt.column, tt.msg)
+			}
+		})
+	}
+
+	if _, err := ReadExamples(writeTestFile(t, "Example 1:\nno data\n")); err == nil {
+		t.Error("ReadExamples of a file without examples returned no error")
+	}
+}
+
+func TestSectionPosition(t *testing.T) {
+	sec := Section{Text: "\ngrid = [[1,3],\n        [4,2]]", Line: 1, Column: 7}
+	tests := []struct {
+		offset       int
+		line, column int
+	}{
+		{0, 1, 7},
+		{1, 2, 1},
+		{8, 2, 8},
+		{25, 3, 10},
+	}
+
+	for _, tt := range tests {
+		line, column := sec.Position(tt.offset)
+		if line != tt.line || column != tt.column {
+			t.Errorf("Position(%d) = %d:%d, want %d:%d", tt.offset, line, column, tt.line, tt.column)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package structures
+
+import (
+	"fmt"
+	"reflect"
+
+	"leetcodedaily/solver"
+)
+
+var (
+	listType      = reflect.TypeOf((*ListNode)(nil))
+	intType       = reflect.TypeOf(0)
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// inputInt returns an integer input of a test case
+func inputInt(input map[string]interface{}, name string) (int, error) {
+	n, err := intValue(input[name])
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+	return n, nil
+}
+
+// checkListParams reports whether a function takes n linked lists
+func checkListParams(fnType reflect.Type, n int) error {
+	if fnType.NumIn() != n {
+		return fmt.Errorf("expected a function with %d *ListNode parameters, got %s", n, fnType)
+	}
+	for i := 0; i < n; i++ {
+		if fnType.In(i) != listType {
+			return fmt.Errorf("parameter %d is %s, expected *ListNode", i, fnType.In(i))
+		}
+	}
+	return nil
+}
+
+// cycleEncoding builds a linked list whose tail may connect back to one of
+// its nodes, from the inputs head = [3,2,0,-4], pos = 1. A returned node is
+// judged as its index in the list, or -1 for nil, so Linked List Cycle II
+// expects Output: 1 where LeetCode prints "tail connects to node index 1".
+type cycleEncoding struct{}
+
+// Inputs implements solver.Encoding
+func (cycleEncoding) Inputs() []string {
+	return []string{"head", "pos"}
+}
+
+// Check implements solver.Encoding
+func (cycleEncoding) Check(fnType reflect.Type) error {
+	return checkListParams(fnType, 1)
+}
+
+// Args implements solver.Encoding
+func (cycleEncoding) Args(fnType reflect.Type, input map[string]interface{}) ([]reflect.Value, error) {
+	pos, err := inputInt(input, "pos")
+	if err != nil {
+		return nil, err
+	}
+	head, err := DecodeCycle(input["head"], pos)
+	if err != nil {
+		return nil, fmt.Errorf("head: %w", err)
+	}
+	return []reflect.Value{reflect.ValueOf(head)}, nil
+}
+
+// OutputType implements solver.Encoding
+func (cycleEncoding) OutputType(t reflect.Type) reflect.Type {
+	if t == listType {
+		return intType
+	}
+	return t
+}
+
+// Output implements solver.Encoding
+func (cycleEncoding) Output(result reflect.Value, args []reflect.Value) (interface{}, error) {
+	node, ok := result.Interface().(*ListNode)
+	if !ok {
+		return result.Interface(), nil
+	}
+	if node == nil {
+		return -1, nil
+	}
+
+	seen := make(map[*ListNode]bool)
+	i := 0
+	for n := args[0].Interface().(*ListNode); n != nil && !seen[n]; n = n.Next {
+		if n == node {
+			return i, nil
+		}
+		seen[n] = true
+		i++
+	}
+	return nil, fmt.Errorf("returned node %d is not a node of the list", node.Val)
+}
+
+// intersectionEncoding builds two linked lists that share their tail, from
+// the inputs intersectVal = 8, listA = [4,1,8,4,5], listB = [5,6,1,8,4,5],
+// skipA = 2, skipB = 3, where the lists share the nodes from listA[skipA] and
+// listB[skipB] on. An intersectVal of 0 means the lists don't intersect. A
+// returned node is judged as its value, or null for nil, so Intersection of
+// Two Linked Lists expects Output: 8 where LeetCode prints "Intersected at
+// '8'". Returning a node other than the intersection is reported as such,
+// even if its value is the same.
+type intersectionEncoding struct{}
+
+// Inputs implements solver.Encoding
+func (intersectionEncoding) Inputs() []string {
+	return []string{"intersectVal", "listA", "listB", "skipA", "skipB"}
+}
+
+// Check implements solver.Encoding
+func (intersectionEncoding) Check(fnType reflect.Type) error {
+	return checkListParams(fnType, 2)
+}
+
+// Args implements solver.Encoding
+func (intersectionEncoding) Args(fnType reflect.Type, input map[string]interface{}) ([]reflect.Value, error) {
+	intersectVal, err := inputInt(input, "intersectVal")
+	if err != nil {
+		return nil, err
+	}
+	skipA, err := inputInt(input, "skipA")
+	if err != nil {
+		return nil, err
+	}
+	skipB, err := inputInt(input, "skipB")
+	if err != nil {
+		return nil, err
+	}
+	valuesA, err := listValues(input["listA"])
+	if err != nil {
+		return nil, fmt.Errorf("listA: %w", err)
+	}
+	valuesB, err := listValues(input["listB"])
+	if err != nil {
+		return nil, fmt.Errorf("listB: %w", err)
+	}
+
+	if intersectVal == 0 {
+		skipA, skipB = len(valuesA), len(valuesB)
+	}
+	if skipA < 0 || skipA > len(valuesA) {
+		return nil, fmt.Errorf("skipA %d is out of range for listA of length %d", skipA, len(valuesA))
+	}
+	if skipB < 0 || skipB > len(valuesB) {
+		return nil, fmt.Errorf("skipB %d is out of range for listB of length %d", skipB, len(valuesB))
+	}
+	tail := valuesA[skipA:]
+	if formatValues(tail) != formatValues(valuesB[skipB:]) {
+		return nil, fmt.Errorf("listA[%d:] %s and listB[%d:] %s must be the same nodes",
+			skipA, formatValues(tail), skipB, formatValues(valuesB[skipB:]))
+	}
+	if intersectVal != 0 && (len(tail) == 0 || fmt.Sprint(tail[0]) != fmt.Sprint(intersectVal)) {
+		return nil, fmt.Errorf("intersectVal %d is not the value of listA[%d]", intersectVal, skipA)
+	}
+
+	headA, err := DecodeList(valuesA)
+	if err != nil {
+		return nil, fmt.Errorf("listA: %w", err)
+	}
+	headB, err := DecodeList(valuesB[:skipB])
+	if err != nil {
+		return nil, fmt.Errorf("listB: %w", err)
+	}
+
+	shared := headA
+	for i := 0; i < skipA; i++ {
+		shared = shared.Next
+	}
+	if headB == nil {
+		headB = shared
+	} else {
+		last := headB
+		for last.Next != nil {
+			last = last.Next
+		}
+		last.Next = shared
+	}
+	return []reflect.Value{reflect.ValueOf(headA), reflect.ValueOf(headB)}, nil
+}
+
+// OutputType implements solver.Encoding
+func (intersectionEncoding) OutputType(t reflect.Type) reflect.Type {
+	if t == listType {
+		return interfaceType
+	}
+	return t
+}
+
+// Output implements solver.Encoding
+func (intersectionEncoding) Output(result reflect.Value, args []reflect.Value) (interface{}, error) {
+	node, ok := result.Interface().(*ListNode)
+	if !ok {
+		return result.Interface(), nil
+	}
+	if node == nil {
+		return nil, nil
+	}
+
+	headA := args[0].Interface().(*ListNode)
+	headB := args[1].Interface().(*ListNode)
+	indexA := make(map[*ListNode]int)
+	for n, i := headA, 0; n != nil; n, i = n.Next, i+1 {
+		if _, seen := indexA[n]; seen {
+			break
+		}
+		indexA[n] = i
+	}
+
+	// The intersection is the first node of listB that is also in listA
+	indexB := make(map[*ListNode]int)
+	for n, i := headB, 0; n != nil; n, i = n.Next, i+1 {
+		if _, seen := indexB[n]; seen {
+			break
+		}
+		indexB[n] = i
+		if _, shared := indexA[n]; shared {
+			if n == node {
+				return node.Val, nil
+			}
+			break
+		}
+	}
+
+	if i, ok := indexA[node]; ok {
+		return fmt.Sprintf("listA[%d] (not the intersection)", i), nil
+	}
+	if i, ok := indexB[node]; ok {
+		return fmt.Sprintf("listB[%d] (not the intersection)", i), nil
+	}
+	return nil, fmt.Errorf("returned node %d is not a node of either list", node.Val)
+}
+
+func init() {
+	solver.RegisterEncoding("cycle", cycleEncoding{})
+	solver.RegisterEncoding("intersection", intersectionEncoding{})
+}
@@ -0,0 +1,299 @@
+package structures
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"leetcodedaily/solver"
+)
+
+// Node is a node of an undirected graph, as defined by LeetCode's Clone Graph
+type Node struct {
+	Val       int
+	Neighbors []*Node
+}
+
+// DecodeGraph builds a connected undirected graph from its adjacency list, in
+// which the list at index i holds the neighbors of the node with value i+1,
+// e.g. [[2,4],[1,3],[2,4],[1,3]]. It returns the node with value 1, or nil
+// for the empty list.
+func DecodeGraph(value interface{}) (*Node, error) {
+	lists, err := listValues(value)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*Node, len(lists))
+	for i := range nodes {
+		nodes[i] = &Node{Val: i + 1}
+	}
+
+	neighbors := make([][]int, len(lists))
+	for i, list := range lists {
+		values, err := listValues(list)
+		if err != nil {
+			return nil, fmt.Errorf("node %d: %w", i+1, err)
+		}
+		for _, value := range values {
+			val, err := intValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("node %d: %w", i+1, err)
+			}
+			if val < 1 || val > len(nodes) {
+				return nil, fmt.Errorf("node %d: neighbor %d is not a node", i+1, val)
+			}
+			if val == i+1 {
+				return nil, fmt.Errorf("node %d: a node can't be its own neighbor", val)
+			}
+			neighbors[i] = append(neighbors[i], val)
+			nodes[i].Neighbors = append(nodes[i].Neighbors, nodes[val-1])
+		}
+	}
+
+	// The graph is undirected, so every edge is listed from both ends
+	for i, list := range neighbors {
+		for _, val := range list {
+			if !slices.Contains(neighbors[val-1], i+1) {
+				return nil, fmt.Errorf("node %d lists %d as a neighbor but not the other way round", i+1, val)
+			}
+		}
+	}
+
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return nodes[0], nil
+}
+
+// EncodeGraph returns the adjacency list of the graph reachable from a node,
+// indexed by the node values, which must be 1 to the number of nodes
+func EncodeGraph(node *Node) ([][]int, error) {
+	adjList := [][]int{}
+	if node == nil {
+		return adjList, nil
+	}
+
+	// Collect the reachable nodes by value
+	byVal := make(map[int]*Node)
+	queue := []*Node{node}
+	byVal[node.Val] = node
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range n.Neighbors {
+			if neighbor == nil {
+				return nil, fmt.Errorf("node %d has a nil neighbor", n.Val)
+			}
+			if other, seen := byVal[neighbor.Val]; seen {
+				if other != neighbor {
+					return nil, fmt.Errorf("two nodes have the value %d", neighbor.Val)
+				}
+				continue
+			}
+			byVal[neighbor.Val] = neighbor
+			queue = append(queue, neighbor)
+		}
+	}
+
+	for val := 1; val <= len(byVal); val++ {
+		n, ok := byVal[val]
+		if !ok {
+			return nil, fmt.Errorf("the node values must be 1 to %d, but %d is missing", len(byVal), val)
+		}
+		neighbors := make([]int, len(n.Neighbors))
+		for i, neighbor := range n.Neighbors {
+			neighbors[i] = neighbor.Val
+		}
+		adjList = append(adjList, neighbors)
+	}
+	return adjList, nil
+}
+
+// CheckClone reports whether clone is a deep copy of the graph reachable from
+// original: no node reachable from clone may be a node of the original. The
+// structure of the copy is compared separately, through EncodeGraph.
+func CheckClone(original, clone *Node) error {
+	originals := make(map[*Node]bool)
+	walkGraph(original, func(n *Node) { originals[n] = true })
+
+	var shared *Node
+	walkGraph(clone, func(n *Node) {
+		if shared == nil && originals[n] {
+			shared = n
+		}
+	})
+	if shared != nil {
+		return fmt.Errorf("node %d of the copy is a node of the original graph", shared.Val)
+	}
+	return nil
+}
+
+// walkGraph calls visit once for every node reachable from a node
+func walkGraph(node *Node, visit func(*Node)) {
+	if node == nil {
+		return
+	}
+	seen := map[*Node]bool{node: true}
+	queue := []*Node{node}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		visit(n)
+		for _, neighbor := range n.Neighbors {
+			if neighbor != nil && !seen[neighbor] {
+				seen[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+}
+
+// String formats the graph as its adjacency list, e.g. "[[2,4],[1,3],[2,4],[1,3]]"
+func (n *Node) String() string {
+	adjList, err := EncodeGraph(n)
+	if err != nil {
+		return fmt.Sprintf("<invalid graph: %v>", err)
+	}
+	lists := make([]interface{}, len(adjList))
+	for i, neighbors := range adjList {
+		values := make([]interface{}, len(neighbors))
+		for j, val := range neighbors {
+			values[j] = val
+		}
+		lists[i] = formatValues(values)
+	}
+	return formatValues(lists)
+}
+
+// graphCodec converts graphs from and to adjacency lists
+type graphCodec struct{}
+
+// Decode implements solver.Codec
+func (graphCodec) Decode(value interface{}) (interface{}, error) {
+	node, err := DecodeGraph(value)
+	if node == nil || err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Encode implements solver.Codec
+func (graphCodec) Encode(value interface{}) (interface{}, error) {
+	return EncodeGraph(value.(*Node))
+}
+
+// NodeGraph returns the nodes of a graph over n nodes built from an edge list,
+// where the node at index i has the value i
+func NodeGraph(n int, edges [][]int, directed bool) []*Node {
+	nodes := make([]*Node, n)
+	for i := range nodes {
+		nodes[i] = &Node{Val: i}
+	}
+	for i, neighbors := range solver.AdjacencyList(n, edges, directed) {
+		for _, j := range neighbors {
+			nodes[i].Neighbors = append(nodes[i].Neighbors, nodes[j])
+		}
+	}
+	return nodes
+}
+
+var nodeType = reflect.TypeOf((*Node)(nil))
+
+// cloneEncoding judges Clone Graph, whose input adjList = [[2,4],[1,3],...]
+// is the graph to copy. The returned graph is judged by CloneChecker as its
+// adjacency list, and fails if it shares any node with the input graph.
+type cloneEncoding struct{}
+
+// Inputs implements solver.Encoding
+func (cloneEncoding) Inputs() []string {
+	return []string{"adjList"}
+}
+
+// Check implements solver.Encoding
+func (cloneEncoding) Check(fnType reflect.Type) error {
+	if fnType.NumIn() != 1 || fnType.In(0) != nodeType {
+		return fmt.Errorf("expected a function with a *Node parameter, got %s", fnType)
+	}
+	return nil
+}
+
+// Args implements solver.Encoding
+func (cloneEncoding) Args(fnType reflect.Type, input map[string]interface{}) ([]reflect.Value, error) {
+	node, err := DecodeGraph(input["adjList"])
+	if err != nil {
+		return nil, fmt.Errorf("adjList: %w", err)
+	}
+	return []reflect.Value{reflect.ValueOf(node)}, nil
+}
+
+// OutputType implements solver.Encoding
+func (cloneEncoding) OutputType(t reflect.Type) reflect.Type {
+	if t == nodeType {
+		return reflect.TypeOf([][]int(nil))
+	}
+	return t
+}
+
+// Output implements solver.Encoding
+func (cloneEncoding) Output(result reflect.Value, args []reflect.Value) (interface{}, error) {
+	clone, ok := result.Interface().(*Node)
+	if !ok {
+		return result.Interface(), nil
+	}
+	adjList, err := EncodeGraph(clone)
+	if err != nil {
+		return nil, err
+	}
+	return ClonedGraph{AdjList: adjList, Original: args[0].Interface().(*Node), Clone: clone}, nil
+}
+
+// Checker implements solver.CheckedEncoding
+func (cloneEncoding) Checker() solver.Checker {
+	return CloneChecker{}
+}
+
+// ClonedGraph is the output of Clone Graph: the adjacency list of the copy,
+// together with the original graph and the copy for CloneChecker
+type ClonedGraph struct {
+	AdjList  [][]int
+	Original *Node
+	Clone    *Node
+}
+
+// String formats the adjacency list of the copy like the expected output
+func (g ClonedGraph) String() string {
+	return fmt.Sprint(g.AdjList)
+}
+
+// CloneChecker accepts a ClonedGraph whose adjacency list is the expected one
+// and which shares no node with the original graph
+type CloneChecker struct{}
+
+// Check implements solver.Checker
+func (CloneChecker) Check(input map[string]interface{}, expected, actual interface{}) solver.Verdict {
+	cloned, ok := actual.(ClonedGraph)
+	if !ok {
+		return solver.CompareChecker{Mode: solver.CompareExact}.Check(input, expected, actual)
+	}
+	if err := CheckClone(cloned.Original, cloned.Clone); err != nil {
+		return solver.Reject("not a deep copy: %v", err)
+	}
+	return solver.CompareChecker{Mode: solver.CompareExact}.Check(input, expected, cloned.AdjList)
+}
+
+// Diff implements solver.Differ
+func (CloneChecker) Diff(expected, actual interface{}) *solver.Diff {
+	if cloned, ok := actual.(ClonedGraph); ok {
+		actual = cloned.AdjList
+	}
+	return solver.CompareExact.Diff(expected, actual)
+}
+
+func init() {
+	solver.RegisterCodec(nodeType, graphCodec{})
+	solver.RegisterGraphType(reflect.TypeOf([]*Node(nil)), func(n int, edges [][]int, directed bool) (interface{}, error) {
+		return NodeGraph(n, edges, directed), nil
+	})
+	solver.RegisterEncoding("clone", cloneEncoding{})
+}
@@ -0,0 +1,112 @@
+package structures
+
+import (
+	"fmt"
+	"reflect"
+
+	"leetcodedaily/solver"
+)
+
+// ListNode is a node of a singly linked list, as defined by LeetCode
+type ListNode struct {
+	Val  int
+	Next *ListNode
+}
+
+// DecodeList builds a linked list from its values, e.g. [1,2,3]. An empty
+// list is the nil list.
+func DecodeList(value interface{}) (*ListNode, error) {
+	values, err := listValues(value)
+	if err != nil {
+		return nil, err
+	}
+
+	dummy := &ListNode{}
+	tail := dummy
+	for i, value := range values {
+		val, err := intValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		tail.Next = &ListNode{Val: val}
+		tail = tail.Next
+	}
+	return dummy.Next, nil
+}
+
+// DecodeCycle builds a linked list whose tail connects to the node at index
+// pos, as in LeetCode's head = [3,2,0,-4], pos = 1. A pos of -1 means the
+// list has no cycle.
+func DecodeCycle(value interface{}, pos int) (*ListNode, error) {
+	head, err := DecodeList(value)
+	if err != nil || pos == -1 {
+		return head, err
+	}
+
+	var nodes []*ListNode
+	for node := head; node != nil; node = node.Next {
+		nodes = append(nodes, node)
+	}
+	if pos < 0 || pos >= len(nodes) {
+		return nil, fmt.Errorf("pos %d is out of range for a list of length %d (use -1 for no cycle)", pos, len(nodes))
+	}
+	nodes[len(nodes)-1].Next = nodes[pos]
+	return head, nil
+}
+
+// EncodeCycle returns the values of a linked list up to its tail, and the
+// index of the node the tail connects to, or -1 if the list has no cycle.
+// It stops at the first node it reaches twice, so it is safe for any list.
+func EncodeCycle(head *ListNode) ([]interface{}, int) {
+	values := []interface{}{}
+	index := make(map[*ListNode]int)
+	for node := head; node != nil; node = node.Next {
+		if i, seen := index[node]; seen {
+			return values, i
+		}
+		index[node] = len(values)
+		values = append(values, node.Val)
+	}
+	return values, -1
+}
+
+// EncodeList returns the values of a linked list. A list with a cycle is an
+// error rather than an endless loop.
+func EncodeList(head *ListNode) ([]interface{}, error) {
+	values, pos := EncodeCycle(head)
+	if pos >= 0 {
+		return nil, fmt.Errorf("the list has a cycle: its tail connects to node index %d", pos)
+	}
+	return values, nil
+}
+
+// String formats the list as its values, e.g. "[1,2,3]", followed by the
+// node the tail connects to if the list has a cycle
+func (l *ListNode) String() string {
+	values, pos := EncodeCycle(l)
+	if pos >= 0 {
+		return fmt.Sprintf("%s (tail connects to node index %d)", formatValues(values), pos)
+	}
+	return formatValues(values)
+}
+
+// listCodec converts linked lists from and to lists of values
+type listCodec struct{}
+
+// Decode implements solver.Codec
+func (listCodec) Decode(value interface{}) (interface{}, error) {
+	head, err := DecodeList(value)
+	if head == nil || err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+// Encode implements solver.Codec
+func (listCodec) Encode(value interface{}) (interface{}, error) {
+	return EncodeList(value.(*ListNode))
+}
+
+func init() {
+	solver.RegisterCodec(reflect.TypeOf((*ListNode)(nil)), listCodec{})
+}
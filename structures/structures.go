@@ -0,0 +1,60 @@
+// Package structures provides the linked data structures used by LeetCode
+// problems, such as TreeNode, ListNode and the graph Node, and the codecs
+// that convert them from and to the literals of test cases.
+//
+// Solutions use the types through an alias, so the code pasted from LeetCode
+// compiles as is:
+//
+//	type TreeNode = structures.TreeNode
+//
+// Importing the package registers the codecs with the solver, which then
+// decodes inputs such as root = [3,9,20,null,null,15,7] into trees and
+// compares and prints returned trees in the same format.
+package structures
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// listValues returns the elements of a parsed list literal
+func listValues(value interface{}) ([]interface{}, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %v (%T)", value, value)
+	}
+
+	values := make([]interface{}, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+	return values, nil
+}
+
+// intValue converts a parsed element to a node value
+func intValue(value interface{}) (int, error) {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || !v.CanInt() {
+		return 0, fmt.Errorf("expected an integer, got %v", value)
+	}
+	n := v.Int()
+	if reflect.Zero(reflect.TypeOf(0)).OverflowInt(n) {
+		return 0, fmt.Errorf("%d overflows int", n)
+	}
+	return int(n), nil
+}
+
+// formatValues formats encoded values the way LeetCode prints them, e.g.
+// "[3,9,20,null,null,15,7]"
+func formatValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		if value == nil {
+			parts[i] = "null"
+		} else {
+			parts[i] = fmt.Sprint(value)
+		}
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
@@ -0,0 +1,144 @@
+package structures
+
+import (
+	"fmt"
+	"reflect"
+
+	"leetcodedaily/solver"
+)
+
+// TreeNode is a node of a binary tree, as defined by LeetCode
+type TreeNode struct {
+	Val   int
+	Left  *TreeNode
+	Right *TreeNode
+}
+
+// DecodeTree builds a tree from its level-order list, in which null marks a
+// missing child, e.g. [3,9,20,null,null,15,7]. An empty list is the empty
+// tree.
+func DecodeTree(value interface{}) (*TreeNode, error) {
+	values, err := listValues(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 || len(values) == 1 && values[0] == nil {
+		return nil, nil
+	}
+
+	newNode := func(i int) (*TreeNode, error) {
+		if values[i] == nil {
+			return nil, nil
+		}
+		val, err := intValue(values[i])
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		return &TreeNode{Val: val}, nil
+	}
+
+	root, err := newNode(0)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, fmt.Errorf("the root is null but more values follow")
+	}
+
+	// Every node in the queue takes the next two values as its children
+	queue := []*TreeNode{root}
+	i := 1
+	for ; i < len(values) && len(queue) > 0; i += 2 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if node.Left, err = newNode(i); err != nil {
+			return nil, err
+		}
+		if node.Left != nil {
+			queue = append(queue, node.Left)
+		}
+
+		if i+1 < len(values) {
+			if node.Right, err = newNode(i + 1); err != nil {
+				return nil, err
+			}
+			if node.Right != nil {
+				queue = append(queue, node.Right)
+			}
+		}
+	}
+	if i < len(values) {
+		return nil, fmt.Errorf("index %d: no parent left for the value %v", i, values[i])
+	}
+	return root, nil
+}
+
+// EncodeTree returns the level-order list of a tree, with null (nil) for the
+// missing children of nodes and without trailing nulls. A node that is
+// reached twice, which makes the structure a graph rather than a tree, is an
+// error.
+func EncodeTree(root *TreeNode) ([]interface{}, error) {
+	values := []interface{}{}
+	if root == nil {
+		return values, nil
+	}
+
+	seen := map[*TreeNode]bool{root: true}
+	queue := []*TreeNode{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node == nil {
+			values = append(values, nil)
+			continue
+		}
+
+		values = append(values, node.Val)
+		for _, child := range []*TreeNode{node.Left, node.Right} {
+			if child != nil {
+				if seen[child] {
+					return nil, fmt.Errorf("node %d is reached twice, the structure is not a tree", child.Val)
+				}
+				seen[child] = true
+			}
+			queue = append(queue, child)
+		}
+	}
+
+	// Trailing nulls carry no information
+	for len(values) > 0 && values[len(values)-1] == nil {
+		values = values[:len(values)-1]
+	}
+	return values, nil
+}
+
+// String formats the tree as its level-order list, e.g. "[3,9,20,null,null,15,7]"
+func (t *TreeNode) String() string {
+	values, err := EncodeTree(t)
+	if err != nil {
+		return fmt.Sprintf("<invalid tree: %v>", err)
+	}
+	return formatValues(values)
+}
+
+// treeCodec converts trees from and to level-order lists
+type treeCodec struct{}
+
+// Decode implements solver.Codec
+func (treeCodec) Decode(value interface{}) (interface{}, error) {
+	root, err := DecodeTree(value)
+	if root == nil || err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// Encode implements solver.Codec
+func (treeCodec) Encode(value interface{}) (interface{}, error) {
+	return EncodeTree(value.(*TreeNode))
+}
+
+func init() {
+	solver.RegisterCodec(reflect.TypeOf((*TreeNode)(nil)), treeCodec{})
+}